@@ -0,0 +1,25 @@
+package provider
+
+import (
+	"net/http"
+)
+
+// setRequestHeaders adds the user agent, the configured static headers and
+// credentials to req. Credentials are applied last so they always win over a
+// free-form header.
+func (c *Client) setRequestHeaders(req *http.Request) {
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+
+	for name, value := range c.Headers {
+		req.Header.Set(name, value)
+	}
+
+	switch {
+	case c.Token != "":
+		req.Header.Set("Authorization", "Bearer "+c.Token)
+	case c.Username != "":
+		req.SetBasicAuth(c.Username, c.Password)
+	}
+}
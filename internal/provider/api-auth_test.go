@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClientAuthentication(t *testing.T) {
+	testCases := map[string]struct {
+		opts              []ClientOption
+		wantAuthorization string
+		wantHeaders       map[string]string
+	}{
+		"unauthenticated": {},
+		"token": {
+			opts:              []ClientOption{WithToken("s3cr3t")},
+			wantAuthorization: "Bearer s3cr3t",
+		},
+		"basic-auth": {
+			opts:              []ClientOption{WithBasicAuth("ryan", "ferrets")},
+			wantAuthorization: "Basic cnlhbjpmZXJyZXRz",
+		},
+		"headers": {
+			opts: []ClientOption{
+				WithToken("s3cr3t"),
+				WithHeaders(map[string]string{"X-Gateway-Key": "abc", "x-team": "ferrets"}),
+			},
+			wantAuthorization: "Bearer s3cr3t",
+			wantHeaders:       map[string]string{"X-Gateway-Key": "abc", "X-Team": "ferrets"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var got http.Header
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got = r.Header.Clone()
+				_, _ = w.Write([]byte(`[]`))
+			}))
+			defer server.Close()
+
+			client, err := NewClient(server.URL, testCase.opts...)
+			if err != nil {
+				t.Fatalf("unexpected error creating client: %s", err)
+			}
+
+			if _, err := client.GetEngineers(context.Background()); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if auth := got.Get("Authorization"); auth != testCase.wantAuthorization {
+				t.Errorf("expected Authorization %q, got: %q", testCase.wantAuthorization, auth)
+			}
+			for name, value := range testCase.wantHeaders {
+				if got.Get(name) != value {
+					t.Errorf("expected header %s to be %q, got: %q", name, value, got.Get(name))
+				}
+			}
+		})
+	}
+}
@@ -2,62 +2,120 @@ package provider
 
 import (
 	"bytes"
+	"context"
+	"crypto/tls"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
-	"log"
 	"net/http"
+	"net/url"
+	"strings"
+	"sync"
 	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 type Client struct {
 	Endpoint   string
 	HTTPClient *http.Client
+
+	// MaxRetries is how many times a failed request is retried. Zero
+	// disables retries.
+	MaxRetries   int
+	RetryMinWait time.Duration
+	RetryMaxWait time.Duration
+
+	// Token is sent as a bearer token. Username and Password are used for
+	// basic auth when no token is set. Headers are added to every request.
+	Token    string
+	Username string
+	Password string
+	Headers  map[string]string
+
+	// UserAgent is sent with every request.
+	UserAgent string
+
+	// PageSize is the number of items requested per page from list
+	// endpoints. Zero lets the API choose.
+	PageSize int
+
+	// StrictDecoding logs API responses with unknown or missing fields.
+	StrictDecoding bool
+
+	// supersededDevETags maps the entity tags of dev team versions replaced
+	// by membership changes of this client to the tags of the versions that
+	// replaced them. The dev team resource follows them, so members added or
+	// removed in the same run don't count as changes outside the run.
+	supersededDevETags sync.Map
+
+	// Construction-only settings consumed by NewClient to build the transport.
+	transport http.RoundTripper
+	tlsConfig *tls.Config
+	proxy     func(*http.Request) (*url.URL, error)
 }
 
-// EngineerModel
-func (c *Client) GetEngineers() ([]EngineerModel, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/engineers", c.Endpoint), nil)
-	if err != nil {
-		return nil, err
-	}
+// Engineer
+func (c *Client) GetEngineers(ctx context.Context) ([]Engineer, error) {
+	return c.ListEngineers(ctx, EngineerFilter{})
+}
 
-	body, err := c.doRequest(req)
+// ListEngineers returns the engineers matching filter from all pages.
+func (c *Client) ListEngineers(ctx context.Context, filter EngineerFilter) ([]Engineer, error) {
+	engineers := []Engineer{}
+	err := c.ListEngineersPages(ctx, filter, func(page []Engineer) error {
+		engineers = append(engineers, page...)
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	engineers := []EngineerModel{}
-	err = json.Unmarshal(body, &engineers)
+	return engineers, nil
+}
+
+// ListEngineersPages calls fn with the engineers matching filter, one page
+// at a time. Returning an error from fn stops the iteration.
+func (c *Client) ListEngineersPages(ctx context.Context, filter EngineerFilter, fn func(page []Engineer) error) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/engineers", c.Endpoint), nil)
 	if err != nil {
-		return nil, err
+		return err
 	}
+	req.URL.RawQuery = filter.query().Encode()
 
-	return engineers, nil
+	return listPages(c, req, func(page []Engineer) error {
+		return fn(filterSlice(page, filter.match))
+	})
 }
 
-func (c *Client) GetEngineerById(id string) (*EngineerModel, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/engineers/id/%s", c.Endpoint, id), nil)
+func (c *Client) GetEngineerById(ctx context.Context, id string) (*Engineer, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/engineers/id/%s", c.Endpoint, id), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.doRequest(req)
+	resp, body, err := c.do(req)
 	if err != nil {
 		return nil, err
 	}
 
-	engineer := EngineerModel{}
-	err = json.Unmarshal(body, &engineer)
+	engineer := Engineer{}
+	err = c.decode(ctx, body, &engineer)
 	if err != nil {
 		return nil, err
 	}
+	engineer.ETag = resp.Header.Get("ETag")
 
 	return &engineer, nil
 }
 
-func (c *Client) CreateEngineer(name, email string) (*EngineerModel, error) {
-	engineer := EngineerModel{
+// CreateEngineer creates an engineer. When the outcome of the request is
+// uncertain, for example because it timed out, an engineer with the email
+// created since the request started is looked up before failing, so an
+// engineer the API did create is not lost.
+func (c *Client) CreateEngineer(ctx context.Context, name string, email *string) (*Engineer, error) {
+	engineer := Engineer{
 		Name:  name,
 		Email: email,
 	}
@@ -67,30 +125,40 @@ func (c *Client) CreateEngineer(name, email string) (*EngineerModel, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/engineers", c.Endpoint), bytes.NewBuffer(engineerBytes))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/engineers", c.Endpoint), bytes.NewBuffer(engineerBytes))
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.doRequest(req)
+	start := time.Now()
+	resp, body, err := c.do(req)
+	if isUncertain(err) && email != nil {
+		if engineer := c.recoverCreatedEngineer(ctx, engineer, start, err); engineer != nil {
+			return engineer, nil
+		}
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	newEngineer := EngineerModel{}
-	err = json.Unmarshal(body, &newEngineer)
+	newEngineer := Engineer{}
+	err = c.decode(ctx, body, &newEngineer)
 	if err != nil {
 		return nil, err
 	}
+	newEngineer.ETag = resp.Header.Get("ETag")
 
 	return &newEngineer, nil
 }
 
-func (c *Client) DeleteEngineer(id string) error {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/engineers/%s", c.Endpoint, id), nil)
+// DeleteEngineer deletes the engineer. Pass WithIfMatch to only delete it if it
+// has not changed since it was read.
+func (c *Client) DeleteEngineer(ctx context.Context, id string, opts ...RequestOption) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/engineers/%s", c.Endpoint, id), nil)
 	if err != nil {
 		return err
 	}
+	applyRequestOptions(req, opts)
 
 	_, err = c.doRequest(req)
 	if err != nil {
@@ -100,8 +168,10 @@ func (c *Client) DeleteEngineer(id string) error {
 	return nil
 }
 
-func (c *Client) UpdateEngineer(id, name, email string) (*EngineerModel, error) {
-	engineer := EngineerModel{
+// UpdateEngineer replaces the engineer. Pass WithIfMatch to only update it if
+// it has not changed since it was read.
+func (c *Client) UpdateEngineer(ctx context.Context, id, name string, email *string, opts ...RequestOption) (*Engineer, error) {
+	engineer := Engineer{
 		Name:  name,
 		Email: email,
 	}
@@ -111,68 +181,130 @@ func (c *Client) UpdateEngineer(id, name, email string) (*EngineerModel, error)
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/engineers/%s", c.Endpoint, id), bytes.NewBuffer(engineerBytes))
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/engineers/%s", c.Endpoint, id), bytes.NewBuffer(engineerBytes))
 	if err != nil {
 		return nil, err
 	}
+	applyRequestOptions(req, opts)
 
-	body, err := c.doRequest(req)
+	resp, body, err := c.do(req)
 	if err != nil {
 		return nil, err
 	}
 
-	updatedEngineer := EngineerModel{}
-	err = json.Unmarshal(body, &updatedEngineer)
+	updatedEngineer := Engineer{}
+	err = c.decode(ctx, body, &updatedEngineer)
 	if err != nil {
 		return nil, err
 	}
+	updatedEngineer.ETag = resp.Header.Get("ETag")
 
 	return &updatedEngineer, nil
 }
 
-// DevModel
-func (c *Client) GetDevs() ([]DevModel, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/dev", c.Endpoint), nil)
+// Dev
+func (c *Client) GetDevs(ctx context.Context) ([]Dev, error) {
+	return c.ListDevs(ctx, DevFilter{})
+}
+
+// ListDevs returns the dev teams matching filter from all pages.
+func (c *Client) ListDevs(ctx context.Context, filter DevFilter) ([]Dev, error) {
+	dev := []Dev{}
+	err := c.ListDevsPages(ctx, filter, func(page []Dev) error {
+		dev = append(dev, page...)
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.doRequest(req)
+	return dev, nil
+}
+
+// ListDevsPages calls fn with the dev teams matching filter, one page at a
+// time. Returning an error from fn stops the iteration.
+func (c *Client) ListDevsPages(ctx context.Context, filter DevFilter, fn func(page []Dev) error) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/dev", c.Endpoint), nil)
+	if err != nil {
+		return err
+	}
+	req.URL.RawQuery = filter.query().Encode()
+
+	return listPages(c, req, func(page []Dev) error {
+		return fn(filterSlice(page, filter.match))
+	})
+}
+
+func (c *Client) GetDevById(ctx context.Context, id string) (*Dev, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/dev/id/%s", c.Endpoint, id), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	dev := []DevModel{}
-	err = json.Unmarshal(body, &dev)
+	resp, body, err := c.do(req)
 	if err != nil {
 		return nil, err
 	}
 
-	return dev, nil
+	dev := Dev{}
+	err = c.decode(ctx, body, &dev)
+	if err != nil {
+		return nil, err
+	}
+	dev.ETag = resp.Header.Get("ETag")
+
+	return &dev, nil
 }
 
-func (c *Client) GetDevById(id string) (*DevModel, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/dev/id/%s", c.Endpoint, id), nil)
+// CreateDev creates a dev team. When the outcome of the request is uncertain,
+// for example because it timed out, a team with the name created since the
+// request started is looked up before failing, so a team the API did create
+// is not lost.
+func (c *Client) CreateDev(ctx context.Context, name string, engineers []Engineer) (*Dev, error) {
+	dev := Dev{
+		Name:      name,
+		Engineers: engineers,
+	}
+
+	devBytes, err := json.Marshal(dev)
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.doRequest(req)
+	tflog.Debug(ctx, "Creating Dev", map[string]any{"payload": string(devBytes)})
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/dev", c.Endpoint), bytes.NewBuffer(devBytes))
 	if err != nil {
 		return nil, err
 	}
 
-	dev := DevModel{}
-	err = json.Unmarshal(body, &dev)
+	start := time.Now()
+	resp, body, err := c.do(req)
+	if isUncertain(err) {
+		if dev := c.recoverCreatedDev(ctx, name, start, err); dev != nil {
+			return dev, nil
+		}
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	return &dev, nil
+	tflog.Debug(ctx, "Created Dev", map[string]any{"response_body": string(body)})
+
+	newDev := Dev{}
+	err = c.decode(ctx, body, &newDev)
+	if err != nil {
+		return nil, err
+	}
+	newDev.ETag = resp.Header.Get("ETag")
+
+	return &newDev, nil
 }
 
-func (c *Client) CreateDev(name string, engineers []EngineerModel) (*DevModel, error) {
-	dev := DevModel{
+// UpdateDev replaces the dev team. Pass WithIfMatch to only update it if it has
+// not changed since it was read.
+func (c *Client) UpdateDev(ctx context.Context, id, name string, engineers []Engineer, opts ...RequestOption) (*Dev, error) {
+	dev := Dev{
 		Name:      name,
 		Engineers: engineers,
 	}
@@ -182,9 +314,65 @@ func (c *Client) CreateDev(name string, engineers []EngineerModel) (*DevModel, e
 		return nil, err
 	}
 
-	log.Printf("Creating Dev: %s", devBytes) // Log the request payload
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/dev/%s", c.Endpoint, id), bytes.NewBuffer(devBytes))
+	if err != nil {
+		return nil, err
+	}
+	applyRequestOptions(req, opts)
+
+	resp, body, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedDev := Dev{}
+	err = c.decode(ctx, body, &updatedDev)
+	if err != nil {
+		return nil, err
+	}
+	updatedDev.ETag = resp.Header.Get("ETag")
+
+	return &updatedDev, nil
+}
+
+// DeleteDev deletes the dev team. Pass WithIfMatch to only delete it if it has
+// not changed since it was read.
+func (c *Client) DeleteDev(ctx context.Context, id string, opts ...RequestOption) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/dev/%s", c.Endpoint, id), nil)
+	if err != nil {
+		return err
+	}
+	applyRequestOptions(req, opts)
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Ops
+func (c *Client) GetOps(ctx context.Context) ([]Ops, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/ops", c.Endpoint), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	ops := []Ops{}
+	err = listPages(c, req, func(page []Ops) error {
+		ops = append(ops, page...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/dev", c.Endpoint), bytes.NewBuffer(devBytes))
+	return ops, nil
+}
+
+func (c *Client) GetOpsById(ctx context.Context, id string) (*Ops, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/ops/id/%s", c.Endpoint, id), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -194,29 +382,61 @@ func (c *Client) CreateDev(name string, engineers []EngineerModel) (*DevModel, e
 		return nil, err
 	}
 
-	log.Printf("Response Body: %s", body) // Log the response body
+	ops := Ops{}
+	err = c.decode(ctx, body, &ops)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ops, nil
+}
+
+func (c *Client) CreateOps(ctx context.Context, name string, engineers []Engineer) (*Ops, error) {
+	ops := Ops{
+		Name:      name,
+		Engineers: engineers,
+	}
+
+	opsBytes, err := json.Marshal(ops)
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.Debug(ctx, "Creating Ops", map[string]any{"payload": string(opsBytes)})
 
-	newDev := DevModel{}
-	err = json.Unmarshal(body, &newDev)
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/ops", c.Endpoint), bytes.NewBuffer(opsBytes))
 	if err != nil {
 		return nil, err
 	}
 
-	return &newDev, nil
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.Debug(ctx, "Created Ops", map[string]any{"response_body": string(body)})
+
+	newOps := Ops{}
+	err = c.decode(ctx, body, &newOps)
+	if err != nil {
+		return nil, err
+	}
+
+	return &newOps, nil
 }
 
-func (c *Client) UpdateDev(id, name string, engineers []EngineerModel) (*DevModel, error) {
-	dev := DevModel{
+func (c *Client) UpdateOps(ctx context.Context, id, name string, engineers []Engineer) (*Ops, error) {
+	ops := Ops{
 		Name:      name,
 		Engineers: engineers,
 	}
 
-	devBytes, err := json.Marshal(dev)
+	opsBytes, err := json.Marshal(ops)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/dev/%s", c.Endpoint, id), bytes.NewBuffer(devBytes))
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/ops/%s", c.Endpoint, id), bytes.NewBuffer(opsBytes))
 	if err != nil {
 		return nil, err
 	}
@@ -226,17 +446,17 @@ func (c *Client) UpdateDev(id, name string, engineers []EngineerModel) (*DevMode
 		return nil, err
 	}
 
-	updatedDev := DevModel{}
-	err = json.Unmarshal(body, &updatedDev)
+	updatedOps := Ops{}
+	err = c.decode(ctx, body, &updatedOps)
 	if err != nil {
 		return nil, err
 	}
 
-	return &updatedDev, nil
+	return &updatedOps, nil
 }
 
-func (c *Client) DeleteDev(id string) error {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/dev/%s", c.Endpoint, id), nil)
+func (c *Client) DeleteOps(ctx context.Context, id string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/ops/%s", c.Endpoint, id), nil)
 	if err != nil {
 		return err
 	}
@@ -249,26 +469,224 @@ func (c *Client) DeleteDev(id string) error {
 	return nil
 }
 
-// general purpose client/request functions
-func (c *Client) doRequest(req *http.Request) ([]byte, error) {
-	// Implementation of the request execution
-	// For example, using http.DefaultClient
-	resp, err := http.DefaultClient.Do(req)
+// DevOps
+func (c *Client) GetDevOps(ctx context.Context) ([]DevOps, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/devops", c.Endpoint), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	devops := []DevOps{}
+	err = listPages(c, req, func(page []DevOps) error {
+		devops = append(devops, page...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return devops, nil
+}
+
+func (c *Client) GetDevOpsById(ctx context.Context, id string) (*DevOps, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/devops/id/%s", c.Endpoint, id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
 
-	return io.ReadAll(resp.Body)
+	devops := DevOps{}
+	err = c.decode(ctx, body, &devops)
+	if err != nil {
+		return nil, err
+	}
+
+	return &devops, nil
+}
+
+// CreateDevOps groups the dev and ops teams with the given ids into a new
+// DevOps unit. The API resolves the ids and returns the full teams.
+func (c *Client) CreateDevOps(ctx context.Context, devIds, opsIds []string) (*DevOps, error) {
+	devopsBytes, err := json.Marshal(newDevOpsRequest(devIds, opsIds))
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/devops", c.Endpoint), bytes.NewBuffer(devopsBytes))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	newDevOps := DevOps{}
+	err = c.decode(ctx, body, &newDevOps)
+	if err != nil {
+		return nil, err
+	}
+
+	return &newDevOps, nil
 }
 
-// NewClient -
-func NewClient(endpoint *string) (*Client, error) {
+func (c *Client) UpdateDevOps(ctx context.Context, id string, devIds, opsIds []string) (*DevOps, error) {
+	devopsBytes, err := json.Marshal(newDevOpsRequest(devIds, opsIds))
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/devops/%s", c.Endpoint, id), bytes.NewBuffer(devopsBytes))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedDevOps := DevOps{}
+	err = c.decode(ctx, body, &updatedDevOps)
+	if err != nil {
+		return nil, err
+	}
+
+	return &updatedDevOps, nil
+}
+
+func (c *Client) DeleteDevOps(ctx context.Context, id string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/devops/%s", c.Endpoint, id), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// newDevOpsRequest builds a DevOps request body that references its teams by id only.
+func newDevOpsRequest(devIds, opsIds []string) devOpsRequest {
+	return devOpsRequest{
+		Dev: newIdRefs(devIds),
+		Ops: newIdRefs(opsIds),
+	}
+}
+
+func newIdRefs(ids []string) []idRef {
+	refs := make([]idRef, len(ids))
+	for i, id := range ids {
+		refs[i] = idRef{Id: id}
+	}
+
+	return refs
+}
+
+// general purpose client/request functions
+func (c *Client) doRequest(req *http.Request) ([]byte, error) {
+	_, body, err := c.do(req)
+	return body, err
+}
+
+// do sends req like doRequest and also returns the response, whose body has
+// already been read and closed, so callers can inspect its headers.
+func (c *Client) do(req *http.Request) (*http.Response, []byte, error) {
+	ctx := tflog.SetField(req.Context(), "devops_api_endpoint", c.Endpoint)
+	ctx = tflog.SetField(ctx, "http_method", req.Method)
+	ctx = tflog.SetField(ctx, "http_url", req.URL.String())
+
+	c.setRequestHeaders(req)
+	setIdempotencyKey(req)
+
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.GetBody != nil {
+			// Rewind the request body consumed by the previous attempt
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, nil, err
+			}
+			req.Body = body
+		}
+
+		tflog.Debug(ctx, "Sending DevOps API request", map[string]any{"attempt": attempt + 1})
+
+		resp, err := c.HTTPClient.Do(req)
+		if err != nil {
+			if attempt < c.MaxRetries && isIdempotent(req) && ctx.Err() == nil {
+				wait := c.backoff(attempt, nil)
+				tflog.Warn(ctx, "DevOps API request failed, retrying", map[string]any{"error": err.Error(), "wait": wait.String()})
+				if err := sleep(ctx, wait); err != nil {
+					return nil, nil, err
+				}
+				continue
+			}
+			return nil, nil, err
+		}
+
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		tflog.Debug(ctx, "Received DevOps API response", map[string]any{"http_status": resp.StatusCode})
+
+		if resp.StatusCode >= 200 && resp.StatusCode <= 299 {
+			return resp, body, nil
+		}
+
+		if attempt < c.MaxRetries && shouldRetry(req, resp.StatusCode) {
+			wait := c.backoff(attempt, resp)
+			tflog.Warn(ctx, "DevOps API request failed, retrying", map[string]any{"http_status": resp.StatusCode, "wait": wait.String()})
+			if err := sleep(ctx, wait); err != nil {
+				return nil, nil, err
+			}
+			continue
+		}
+
+		return nil, nil, newAPIError(req, resp, body)
+	}
+}
+
+// NewClient creates a DevOps API client for the given endpoint, for example
+// http://localhost:8080. Options are applied in order.
+func NewClient(endpoint string, opts ...ClientOption) (*Client, error) {
+	if endpoint == "" {
+		return nil, errors.New("endpoint must not be empty")
+	}
+	if _, err := url.ParseRequestURI(endpoint); err != nil {
+		return nil, fmt.Errorf("invalid endpoint %q: %w", endpoint, err)
+	}
+
 	c := Client{
-		HTTPClient: &http.Client{Timeout: 10 * time.Second},
-		// Default Hashicups URL
-		Endpoint: *endpoint,
+		HTTPClient:   &http.Client{Timeout: defaultTimeout},
+		Endpoint:     strings.TrimSuffix(endpoint, "/"),
+		MaxRetries:   defaultMaxRetries,
+		RetryMinWait: defaultRetryMinWait,
+		RetryMaxWait: defaultRetryMaxWait,
+		UserAgent:    defaultUserAgent,
+	}
+
+	for _, opt := range opts {
+		if err := opt(&c); err != nil {
+			return nil, err
+		}
+	}
+
+	transport, err := c.buildTransport()
+	if err != nil {
+		return nil, err
 	}
+	c.HTTPClient.Transport = transport
 
 	return &c, nil
 }
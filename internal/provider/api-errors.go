@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// maxErrorBodyLength caps how much of a non-JSON error body is kept in an APIError.
+const maxErrorBodyLength = 512
+
+// APIError is returned by every Client method when the DevOps API answers
+// with a non-2xx status code.
+type APIError struct {
+	Method     string
+	URL        string
+	StatusCode int
+	RequestID  string
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("%s %s returned %d %s", e.Method, e.URL, e.StatusCode, http.StatusText(e.StatusCode))
+	if e.Message != "" {
+		msg += ": " + e.Message
+	}
+	if e.RequestID != "" {
+		msg += " (request id: " + e.RequestID + ")"
+	}
+
+	return msg
+}
+
+// IsNotFound reports whether err is an APIError with a 404 status code.
+func IsNotFound(err error) bool {
+	return hasStatusCode(err, http.StatusNotFound)
+}
+
+// IsPreconditionFailed reports whether err is an APIError with a 412 status
+// code, returned when a conditional request finds the resource changed.
+func IsPreconditionFailed(err error) bool {
+	return hasStatusCode(err, http.StatusPreconditionFailed)
+}
+
+func hasStatusCode(err error, statusCode int) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == statusCode
+	}
+
+	return false
+}
+
+func newAPIError(req *http.Request, resp *http.Response, body []byte) *APIError {
+	return &APIError{
+		Method:     req.Method,
+		URL:        req.URL.String(),
+		StatusCode: resp.StatusCode,
+		RequestID:  resp.Header.Get("X-Request-Id"),
+		Message:    parseErrorMessage(body),
+	}
+}
+
+// parseErrorMessage extracts the server error message from a response body.
+// The API reports errors as {"message": "..."} or {"error": "..."}; anything
+// else is returned as trimmed text.
+func parseErrorMessage(body []byte) string {
+	var payload struct {
+		Message string `json:"message"`
+		Error   string `json:"error"`
+	}
+	if err := json.Unmarshal(body, &payload); err == nil {
+		if payload.Message != "" {
+			return payload.Message
+		}
+		if payload.Error != "" {
+			return payload.Error
+		}
+	}
+
+	msg := strings.TrimSpace(string(body))
+	if len(msg) > maxErrorBodyLength {
+		msg = msg[:maxErrorBodyLength] + "..."
+	}
+
+	return msg
+}
@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClientAPIError(t *testing.T) {
+	testCases := map[string]struct {
+		statusCode  int
+		body        string
+		wantMessage string
+		notFound    bool
+	}{
+		"not-found-message": {
+			statusCode:  http.StatusNotFound,
+			body:        `{"message": "engineer not found"}`,
+			wantMessage: "engineer not found",
+			notFound:    true,
+		},
+		"conflict-error": {
+			statusCode:  http.StatusConflict,
+			body:        `{"error": "email already in use"}`,
+			wantMessage: "email already in use",
+		},
+		"internal-plain-text": {
+			statusCode:  http.StatusInternalServerError,
+			body:        "boom\n",
+			wantMessage: "boom",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("X-Request-Id", "req-123")
+				w.WriteHeader(testCase.statusCode)
+				_, _ = w.Write([]byte(testCase.body))
+			}))
+			defer server.Close()
+
+			client, err := NewClient(server.URL)
+			if err != nil {
+				t.Fatalf("unexpected error creating client: %s", err)
+			}
+
+			_, err = client.GetEngineerById(context.Background(), "abc")
+
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("expected *APIError, got: %v", err)
+			}
+
+			if apiErr.Method != http.MethodGet {
+				t.Errorf("expected method GET, got: %s", apiErr.Method)
+			}
+			if apiErr.URL != server.URL+"/engineers/id/abc" {
+				t.Errorf("unexpected URL: %s", apiErr.URL)
+			}
+			if apiErr.StatusCode != testCase.statusCode {
+				t.Errorf("expected status %d, got: %d", testCase.statusCode, apiErr.StatusCode)
+			}
+			if apiErr.RequestID != "req-123" {
+				t.Errorf("expected request id req-123, got: %s", apiErr.RequestID)
+			}
+			if apiErr.Message != testCase.wantMessage {
+				t.Errorf("expected message %q, got: %q", testCase.wantMessage, apiErr.Message)
+			}
+			if IsNotFound(err) != testCase.notFound {
+				t.Errorf("expected IsNotFound to be %t", testCase.notFound)
+			}
+		})
+	}
+}
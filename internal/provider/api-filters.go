@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// EngineerFilter narrows down the engineers returned by ListEngineers. Zero
+// fields match everything.
+type EngineerFilter struct {
+	NameRegex   *regexp.Regexp
+	EmailDomain string
+	// Ids matches the engineers with these ids when not nil, so an empty
+	// slice matches nothing.
+	Ids []string
+}
+
+// DevFilter narrows down the dev teams returned by ListDevs. Zero fields match
+// everything.
+type DevFilter struct {
+	NameRegex *regexp.Regexp
+	// EmailDomain matches teams with at least one engineer in the domain.
+	EmailDomain string
+	// Ids matches the teams with these ids when not nil, so an empty slice
+	// matches nothing.
+	Ids []string
+	// HasEngineer matches teams containing the engineer with this id.
+	HasEngineer string
+}
+
+// query returns the filter as query parameters. The API ignores parameters
+// it does not support, so results must still be checked with match. Regular
+// expressions are only applied client-side.
+func (f EngineerFilter) query() url.Values {
+	query := url.Values{}
+	if f.EmailDomain != "" {
+		query.Set("email_domain", f.EmailDomain)
+	}
+	for _, id := range f.Ids {
+		query.Add("id", id)
+	}
+	return query
+}
+
+func (f EngineerFilter) match(engineer Engineer) bool {
+	if f.NameRegex != nil && !f.NameRegex.MatchString(engineer.Name) {
+		return false
+	}
+	if f.EmailDomain != "" && !inEmailDomain(engineer.emailAddress(), f.EmailDomain) {
+		return false
+	}
+	if f.Ids != nil && !slices.Contains(f.Ids, engineer.Id) {
+		return false
+	}
+	return true
+}
+
+// query returns the filter as query parameters, see EngineerFilter.query.
+func (f DevFilter) query() url.Values {
+	query := url.Values{}
+	if f.EmailDomain != "" {
+		query.Set("email_domain", f.EmailDomain)
+	}
+	for _, id := range f.Ids {
+		query.Add("id", id)
+	}
+	if f.HasEngineer != "" {
+		query.Set("has_engineer", f.HasEngineer)
+	}
+	return query
+}
+
+func (f DevFilter) match(dev Dev) bool {
+	if f.NameRegex != nil && !f.NameRegex.MatchString(dev.Name) {
+		return false
+	}
+	if f.EmailDomain != "" && !slices.ContainsFunc(dev.Engineers, func(e Engineer) bool {
+		return inEmailDomain(e.emailAddress(), f.EmailDomain)
+	}) {
+		return false
+	}
+	if f.Ids != nil && !slices.Contains(f.Ids, dev.Id) {
+		return false
+	}
+	if f.HasEngineer != "" && !slices.ContainsFunc(dev.Engineers, func(e Engineer) bool {
+		return e.Id == f.HasEngineer
+	}) {
+		return false
+	}
+	return true
+}
+
+// inEmailDomain reports whether email belongs to domain, ignoring case.
+func inEmailDomain(email, domain string) bool {
+	emailDomain, ok := emailDomain(email)
+	return ok && emailDomain == normalizeEmail(domain)
+}
+
+// filterSlice returns the elements of s for which match returns true.
+func filterSlice[T any](s []T, match func(T) bool) []T {
+	filtered := make([]T, 0, len(s))
+	for _, v := range s {
+		if match(v) {
+			filtered = append(filtered, v)
+		}
+	}
+	return filtered
+}
+
+// FindEngineersByEmail returns the engineers with the given email, compared
+// case-insensitively.
+func (c *Client) FindEngineersByEmail(ctx context.Context, email string) ([]Engineer, error) {
+	_, domain, _ := strings.Cut(email, "@")
+	engineers, err := c.ListEngineers(ctx, EngineerFilter{EmailDomain: domain})
+	if err != nil {
+		return nil, err
+	}
+
+	return filterSlice(engineers, func(engineer Engineer) bool {
+		return normalizeEmail(engineer.emailAddress()) == normalizeEmail(email)
+	}), nil
+}
+
+// FindDevsByName returns the dev teams with the given name.
+func (c *Client) FindDevsByName(ctx context.Context, name string) ([]Dev, error) {
+	devs, err := c.GetDevs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return filterSlice(devs, func(dev Dev) bool {
+		return dev.Name == name
+	}), nil
+}
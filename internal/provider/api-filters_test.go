@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestEngineerFilterMatch(t *testing.T) {
+	engineers := []Engineer{
+		{Id: "1", Name: "Ryan", Email: stringPointer("ryan@ferrets.com")},
+		{Id: "2", Name: "zach", Email: stringPointer("zach@Bengal.com")},
+		{Id: "3", Name: "bob", Email: stringPointer("bob@bob.com")},
+	}
+
+	testCases := map[string]struct {
+		filter  EngineerFilter
+		wantIds []string
+	}{
+		"empty": {
+			filter:  EngineerFilter{},
+			wantIds: []string{"1", "2", "3"},
+		},
+		"name-regex": {
+			filter:  EngineerFilter{NameRegex: regexp.MustCompile("^[a-z]")},
+			wantIds: []string{"2", "3"},
+		},
+		"email-domain-ignores-case": {
+			filter:  EngineerFilter{EmailDomain: "BENGAL.com"},
+			wantIds: []string{"2"},
+		},
+		"ids": {
+			filter:  EngineerFilter{Ids: []string{"1", "3", "4"}},
+			wantIds: []string{"1", "3"},
+		},
+		"empty-ids": {
+			filter:  EngineerFilter{Ids: []string{}},
+			wantIds: []string{},
+		},
+		"combined": {
+			filter:  EngineerFilter{NameRegex: regexp.MustCompile("b"), Ids: []string{"1", "2"}},
+			wantIds: []string{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotIds := []string{}
+			for _, engineer := range filterSlice(engineers, testCase.filter.match) {
+				gotIds = append(gotIds, engineer.Id)
+			}
+
+			if diff := cmp.Diff(testCase.wantIds, gotIds); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDevFilterMatch(t *testing.T) {
+	devs := []Dev{
+		{Id: "10", Name: "dev_ferrets", Engineers: []Engineer{{Id: "1", Email: stringPointer("ryan@ferrets.com")}}},
+		{Id: "11", Name: "dev_bengal", Engineers: []Engineer{{Id: "2", Email: stringPointer("zach@bengal.com")}}},
+		{Id: "12", Name: "dev_empty"},
+	}
+
+	testCases := map[string]struct {
+		filter  DevFilter
+		wantIds []string
+	}{
+		"empty": {
+			filter:  DevFilter{},
+			wantIds: []string{"10", "11", "12"},
+		},
+		"name-regex": {
+			filter:  DevFilter{NameRegex: regexp.MustCompile("ferrets|empty")},
+			wantIds: []string{"10", "12"},
+		},
+		"email-domain": {
+			filter:  DevFilter{EmailDomain: "bengal.com"},
+			wantIds: []string{"11"},
+		},
+		"ids": {
+			filter:  DevFilter{Ids: []string{"12"}},
+			wantIds: []string{"12"},
+		},
+		"empty-ids": {
+			filter:  DevFilter{Ids: []string{}},
+			wantIds: []string{},
+		},
+		"has-engineer": {
+			filter:  DevFilter{HasEngineer: "1"},
+			wantIds: []string{"10"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotIds := []string{}
+			for _, dev := range filterSlice(devs, testCase.filter.match) {
+				gotIds = append(gotIds, dev.Id)
+			}
+
+			if diff := cmp.Diff(testCase.wantIds, gotIds); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestClientListEngineersQuery(t *testing.T) {
+	var gotQuery url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.Query()
+		// Ignore the filter like an API without filtering support would.
+		_, _ = w.Write([]byte(`[{"id": "1", "name": "Ryan", "email": "ryan@ferrets.com"}, {"id": "2", "name": "zach", "email": "zach@bengal.com"}]`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error creating client: %s", err)
+	}
+
+	engineers, err := client.ListEngineers(context.Background(), EngineerFilter{
+		NameRegex:   regexp.MustCompile("^R"),
+		EmailDomain: "ferrets.com",
+		Ids:         []string{"1", "2"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	wantQuery := url.Values{"email_domain": {"ferrets.com"}, "id": {"1", "2"}}
+	if diff := cmp.Diff(wantQuery, gotQuery); diff != "" {
+		t.Errorf("unexpected query difference: %s", diff)
+	}
+
+	wantEngineers := []Engineer{{Id: "1", Name: "Ryan", Email: stringPointer("ryan@ferrets.com")}}
+	if diff := cmp.Diff(wantEngineers, engineers); diff != "" {
+		t.Errorf("unexpected engineers difference: %s", diff)
+	}
+}
@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// idempotencyKeyHeader carries a unique key per POST request. The API stores
+// the response for each key and replays it when a request with the same key
+// arrives again, so a retried create returns the original object instead of
+// creating a duplicate.
+const idempotencyKeyHeader = "Idempotency-Key"
+
+// recoveryTimeout bounds the lookup of the object a failed create may have
+// created.
+const recoveryTimeout = 30 * time.Second
+
+// setIdempotencyKey adds a new idempotency key to POST requests that don't
+// have one yet. The key is kept for every attempt of the request.
+func setIdempotencyKey(req *http.Request) {
+	if req.Method != http.MethodPost || req.Header.Get(idempotencyKeyHeader) != "" {
+		return
+	}
+
+	key, err := newIdempotencyKey()
+	if err != nil {
+		tflog.Warn(req.Context(), "Unable to generate an idempotency key, the request will not be retried", map[string]any{"error": err.Error()})
+		return
+	}
+	req.Header.Set(idempotencyKeyHeader, key)
+}
+
+// newIdempotencyKey returns a random version 4 UUID.
+func newIdempotencyKey() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", err
+	}
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16]), nil
+}
+
+// isUncertain reports whether a failed request may still have been committed
+// by the API: the response was lost, or the API failed with a server error.
+// Other API errors are definite rejections.
+func isUncertain(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return false
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode >= http.StatusInternalServerError
+	}
+
+	return true
+}
+
+// recoveryContext returns the context to look up the object of a failed create
+// with. The create often failed because the deadline of ctx passed, so the
+// lookup isn't canceled with ctx but gets its own timeout.
+func recoveryContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.WithoutCancel(ctx), recoveryTimeout)
+}
+
+// recoverCreatedEngineer looks up the engineer a create request with an
+// uncertain outcome may have created. Only an engineer with the requested name
+// and email that was created since the request started is accepted, so an
+// engineer that already existed is never mistaken for the created one. It
+// returns nil unless exactly one engineer qualifies.
+func (c *Client) recoverCreatedEngineer(ctx context.Context, requested Engineer, start time.Time, createErr error) *Engineer {
+	ctx, cancel := recoveryContext(ctx)
+	defer cancel()
+
+	matches, err := c.FindEngineersByEmail(ctx, requested.emailAddress())
+	if err != nil {
+		tflog.Warn(ctx, "Unable to look up the engineer of a failed create", map[string]any{"error": err.Error()})
+		return nil
+	}
+	matches = filterSlice(matches, func(engineer Engineer) bool {
+		return strings.TrimSpace(engineer.Name) == strings.TrimSpace(requested.Name) && createdSince(engineer.CreatedAt, start)
+	})
+	if len(matches) != 1 {
+		return nil
+	}
+
+	tflog.Warn(ctx, "Engineer create failed but the engineer was created, using it", map[string]any{
+		"id":           matches[0].Id,
+		"create_error": createErr.Error(),
+	})
+	return &matches[0]
+}
+
+// recoverCreatedDev looks up the dev team a create request with an uncertain
+// outcome may have created. Only a dev team with the name that was created
+// since the request started is accepted, see recoverCreatedEngineer. It
+// returns nil unless exactly one dev team qualifies.
+func (c *Client) recoverCreatedDev(ctx context.Context, name string, start time.Time, createErr error) *Dev {
+	ctx, cancel := recoveryContext(ctx)
+	defer cancel()
+
+	matches, err := c.FindDevsByName(ctx, name)
+	if err != nil {
+		tflog.Warn(ctx, "Unable to look up the dev team of a failed create", map[string]any{"error": err.Error()})
+		return nil
+	}
+	matches = filterSlice(matches, func(dev Dev) bool {
+		return createdSince(dev.CreatedAt, start)
+	})
+	if len(matches) != 1 {
+		return nil
+	}
+
+	tflog.Warn(ctx, "Dev team create failed but the dev team was created, using it", map[string]any{
+		"id":           matches[0].Id,
+		"create_error": createErr.Error(),
+	})
+	return &matches[0]
+}
+
+// createdSince reports whether the created_at timestamp of an object is not
+// before start. The API reports whole seconds, so start is truncated to the
+// second. Objects without a valid timestamp never qualify.
+func createdSince(createdAt *string, start time.Time) bool {
+	if createdAt == nil {
+		return false
+	}
+	created, err := time.Parse(time.RFC3339, *createdAt)
+	if err != nil {
+		return false
+	}
+
+	return !created.Before(start.Truncate(time.Second))
+}
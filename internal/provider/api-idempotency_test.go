@@ -0,0 +1,187 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestClientCreateRecovery(t *testing.T) {
+	createEngineer := func(name string, email *string) func(context.Context, *Client) (string, error) {
+		return func(ctx context.Context, client *Client) (string, error) {
+			engineer, err := client.CreateEngineer(ctx, name, email)
+			if err != nil {
+				return "", err
+			}
+			return engineer.Id, nil
+		}
+	}
+	createDev := func(name string) func(context.Context, *Client) (string, error) {
+		return func(ctx context.Context, client *Client) (string, error) {
+			dev, err := client.CreateDev(ctx, name, nil)
+			if err != nil {
+				return "", err
+			}
+			return dev.Id, nil
+		}
+	}
+
+	testCases := map[string]struct {
+		setup      func(*fakeAPIServer)
+		create     func(context.Context, *Client) (string, error)
+		maxRetries int
+		lose       int
+		reject     bool
+		fail       bool
+		timeout    time.Duration
+		wantId     string
+		wantErr    bool
+	}{
+		"retry-replays-original": {
+			create:     createEngineer("Lost", stringPointer("lost@example.com")),
+			maxRetries: 1,
+			lose:       1,
+			wantId:     "00009",
+		},
+		"engineer-recovered-by-email": {
+			create: createEngineer("Lost", stringPointer("Lost@Example.com")),
+			lose:   1,
+			wantId: "00009",
+		},
+		"engineer-timed-out": {
+			create:  createEngineer("Lost", stringPointer("slow@example.com")),
+			timeout: 100 * time.Millisecond,
+			wantId:  "00009",
+		},
+		"dev-timed-out": {
+			create:  createDev("dev_slow"),
+			timeout: 100 * time.Millisecond,
+			wantId:  "00009",
+		},
+		"engineer-without-email": {
+			create:  createEngineer("Lost", nil),
+			lose:    1,
+			wantErr: true,
+		},
+		"engineer-rejected": {
+			create:  createEngineer("Lost", stringPointer("ryan@ferrets.com")),
+			reject:  true,
+			wantErr: true,
+		},
+		"dev-recovered-by-name": {
+			create: createDev("dev_lost"),
+			lose:   1,
+			wantId: "00009",
+		},
+		"dev-name-of-existing": {
+			create: createDev("dev_ferrets"),
+			lose:   1,
+			wantId: "00009",
+		},
+		"dev-name-ambiguous": {
+			// Created within the second the request starts, so it can't be
+			// told apart from the created team
+			setup: func(api *fakeAPIServer) {
+				api.devs.add(fakeTeam{Name: "dev_twin"})
+			},
+			create:  createDev("dev_twin"),
+			lose:    1,
+			wantErr: true,
+		},
+		"engineer-existing-not-committed": {
+			create:  createEngineer("Ryan", stringPointer("ryan@ferrets.com")),
+			fail:    true,
+			wantErr: true,
+		},
+		"dev-existing-not-committed": {
+			create:  createDev("dev_bengal"),
+			fail:    true,
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			api := newFakeAPIServer()
+			api.seed()
+			defer api.Close()
+			if testCase.setup != nil {
+				testCase.setup(api)
+			}
+
+			// Commit creates but lose their responses, answer them after the
+			// client gave up, fail them without committing, or reject them
+			lose := testCase.lose
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.Method == http.MethodPost && testCase.reject:
+					writeError(w, http.StatusConflict, "already exists")
+				case r.Method == http.MethodPost && testCase.fail:
+					writeError(w, http.StatusServiceUnavailable, "service unavailable")
+				case r.Method == http.MethodPost && testCase.timeout > 0:
+					api.Config.Handler.ServeHTTP(httptest.NewRecorder(), r)
+					<-r.Context().Done()
+				case r.Method == http.MethodPost && lose > 0:
+					lose--
+					api.Config.Handler.ServeHTTP(httptest.NewRecorder(), r)
+					writeError(w, http.StatusGatewayTimeout, "gateway timeout")
+				default:
+					api.Config.Handler.ServeHTTP(w, r)
+				}
+			}))
+			defer server.Close()
+
+			client, err := NewClient(server.URL, WithRetry(testCase.maxRetries, 0, 0))
+			if err != nil {
+				t.Fatalf("unexpected error creating client: %s", err)
+			}
+
+			ctx := context.Background()
+			if testCase.timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, testCase.timeout)
+				defer cancel()
+			}
+
+			id, err := testCase.create(ctx, client)
+
+			if testCase.wantErr {
+				if err == nil {
+					t.Errorf("expected error, got id: %s", id)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if id != testCase.wantId {
+				t.Errorf("expected id %s, got: %s", testCase.wantId, id)
+			}
+			if api.nextId != 9 {
+				t.Errorf("expected a single object to be created, got: %d", api.nextId-8)
+			}
+		})
+	}
+}
+
+func TestNewIdempotencyKey(t *testing.T) {
+	uuid := regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+
+	seen := map[string]bool{}
+	for i := 0; i < 100; i++ {
+		key, err := newIdempotencyKey()
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if !uuid.MatchString(key) {
+			t.Errorf("expected a version 4 UUID, got: %s", key)
+		}
+		if seen[key] {
+			t.Errorf("expected unique keys, got %s twice", key)
+		}
+		seen[key] = true
+	}
+}
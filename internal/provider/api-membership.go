@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// membershipAttempts is how many times a membership change is retried when
+// another writer changed the dev team at the same time.
+const membershipAttempts = 3
+
+// ErrConcurrentModification is returned when a dev team keeps being changed by
+// another writer while a membership change is applied.
+var ErrConcurrentModification = errors.New("dev was modified concurrently")
+
+// devLocks serializes membership changes of the same dev team within the
+// provider process, since Terraform applies resources in parallel.
+var devLocks sync.Map
+
+func lockDev(id string) func() {
+	lock, _ := devLocks.LoadOrStore(id, &sync.Mutex{})
+	mu, _ := lock.(*sync.Mutex)
+	mu.Lock()
+	return mu.Unlock
+}
+
+// devETagKey identifies a version of a dev team by its entity tag.
+type devETagKey struct {
+	devId string
+	etag  string
+}
+
+// currentDevETag returns the entity tag of the dev team after the membership
+// changes this client made since the version with etag was read. Only writes
+// that keep the current members may use it as If-Match; a write of the
+// members last read must use etag, so it fails instead of undoing the changes.
+func (c *Client) currentDevETag(devId, etag string) string {
+	for etag != "" {
+		next, ok := c.supersededDevETags.Load(devETagKey{devId, etag})
+		if !ok {
+			break
+		}
+		etag, _ = next.(string)
+	}
+
+	return etag
+}
+
+// AddDevEngineer adds the engineer to the dev team, keeping its other members.
+// Adding a member twice is a no-op.
+func (c *Client) AddDevEngineer(ctx context.Context, devId, engineerId string) (*Dev, error) {
+	engineer, err := c.GetEngineerById(ctx, engineerId)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.modifyDevEngineers(ctx, devId, engineerId, true, func(engineers []Engineer) []Engineer {
+		return append(engineers, *engineer)
+	})
+}
+
+// RemoveDevEngineer removes the engineer from the dev team, keeping its other
+// members. Removing an engineer that is not a member is a no-op.
+func (c *Client) RemoveDevEngineer(ctx context.Context, devId, engineerId string) (*Dev, error) {
+	return c.modifyDevEngineers(ctx, devId, engineerId, false, func(engineers []Engineer) []Engineer {
+		return slices.DeleteFunc(engineers, func(e Engineer) bool {
+			return e.Id == engineerId
+		})
+	})
+}
+
+// modifyDevEngineers applies modify to the engineers of the dev team until the
+// engineer's membership matches member. The API replaces the whole team on
+// update, so the team is read back after writing to detect a concurrent update
+// that overwrote the change.
+func (c *Client) modifyDevEngineers(ctx context.Context, devId, engineerId string, member bool, modify func([]Engineer) []Engineer) (*Dev, error) {
+	defer lockDev(devId)()
+
+	for attempt := 1; ; attempt++ {
+		dev, err := c.GetDevById(ctx, devId)
+		if err != nil {
+			return nil, err
+		}
+		if isDevMember(dev, engineerId) == member {
+			return dev, nil
+		}
+		if attempt > membershipAttempts {
+			return nil, fmt.Errorf("%w: engineer %s membership of dev %s was overwritten %d times", ErrConcurrentModification, engineerId, devId, membershipAttempts)
+		}
+		if attempt > 1 {
+			tflog.Warn(ctx, "Dev membership change was overwritten, retrying", map[string]any{"dev_id": devId, "engineer_id": engineerId})
+		}
+
+		// A conditional update rejected because of a concurrent write is
+		// retried like an overwritten one
+		updated, err := c.UpdateDev(ctx, devId, dev.Name, modify(dev.Engineers), WithIfMatch(dev.ETag))
+		if err != nil && !IsPreconditionFailed(err) {
+			return nil, err
+		}
+		if err == nil && dev.ETag != "" && updated.ETag != "" {
+			c.supersededDevETags.Store(devETagKey{devId, dev.ETag}, updated.ETag)
+		}
+	}
+}
+
+func isDevMember(dev *Dev, engineerId string) bool {
+	return slices.ContainsFunc(dev.Engineers, func(e Engineer) bool {
+		return e.Id == engineerId
+	})
+}
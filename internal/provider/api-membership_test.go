@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestClientDevEngineerMembership(t *testing.T) {
+	testCases := map[string]struct {
+		add          bool
+		engineerId   string
+		clobbers     int
+		conflicts    int
+		wantIds      []string
+		wantRequests int
+		wantErr      error
+
+		// wantETag is the entity tag the dev team read before the change
+		// is expected to be superseded by.
+		wantETag string
+	}{
+		"add": {
+			add:          true,
+			engineerId:   "00002",
+			wantIds:      []string{"00001", "00002"},
+			wantRequests: 4,
+			wantETag:     `"2"`,
+		},
+		"add-existing-member": {
+			add:          true,
+			engineerId:   "00001",
+			wantIds:      []string{"00001"},
+			wantRequests: 2,
+		},
+		"add-retries-after-overwrite": {
+			add:          true,
+			engineerId:   "00002",
+			clobbers:     1,
+			wantIds:      []string{"00002"},
+			wantRequests: 6,
+		},
+		"add-retries-after-conflict": {
+			add:          true,
+			engineerId:   "00002",
+			conflicts:    1,
+			wantIds:      []string{"00001", "00002"},
+			wantRequests: 6,
+			wantETag:     `"1"`,
+		},
+		"add-gives-up": {
+			add:          true,
+			engineerId:   "00002",
+			clobbers:     membershipAttempts,
+			wantErr:      ErrConcurrentModification,
+			wantRequests: 8,
+		},
+		"remove": {
+			engineerId:   "00001",
+			wantIds:      []string{},
+			wantRequests: 3,
+		},
+		"remove-non-member": {
+			engineerId:   "00003",
+			wantIds:      []string{"00001"},
+			wantRequests: 1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			api := newFakeAPIServer()
+			api.seed()
+			defer api.Close()
+
+			// Simulate another writer changing the team right before or
+			// replacing it right after each of the client's updates
+			clobbers, conflicts := testCase.clobbers, testCase.conflicts
+			requests := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				if r.Method == http.MethodPut && conflicts > 0 {
+					conflicts--
+					api.mu.Lock()
+					_, dev := api.devs.find("00004")
+					dev.Version++
+					api.mu.Unlock()
+				}
+				api.Config.Handler.ServeHTTP(w, r)
+				if r.Method == http.MethodPut && clobbers > 0 {
+					clobbers--
+					api.mu.Lock()
+					_, dev := api.devs.find("00004")
+					dev.Engineers = []fakeEngineer{}
+					api.mu.Unlock()
+				}
+			}))
+			defer server.Close()
+
+			client, err := NewClient(server.URL)
+			if err != nil {
+				t.Fatalf("unexpected error creating client: %s", err)
+			}
+
+			var dev *Dev
+			if testCase.add {
+				dev, err = client.AddDevEngineer(context.Background(), "00004", testCase.engineerId)
+			} else {
+				dev, err = client.RemoveDevEngineer(context.Background(), "00004", testCase.engineerId)
+			}
+
+			if requests != testCase.wantRequests {
+				t.Errorf("expected %d requests, got: %d", testCase.wantRequests, requests)
+			}
+			if testCase.wantErr != nil {
+				if !errors.Is(err, testCase.wantErr) {
+					t.Errorf("expected error %q, got: %v", testCase.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			gotIds := []string{}
+			for _, engineer := range dev.Engineers {
+				gotIds = append(gotIds, engineer.Id)
+			}
+			if diff := cmp.Diff(testCase.wantIds, gotIds); diff != "" {
+				t.Errorf("unexpected engineers difference: %s", diff)
+			}
+			if testCase.wantETag != "" {
+				if got := client.currentDevETag("00004", `"1"`); got != testCase.wantETag {
+					t.Errorf("expected entity tag %s, got: %s", testCase.wantETag, got)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,184 @@
+package provider
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const (
+	defaultTimeout   = 10 * time.Second
+	defaultUserAgent = "terraform-provider-devops-bootcamp"
+)
+
+// ClientOption configures a Client created with NewClient.
+type ClientOption func(*Client) error
+
+// WithTransport makes the client send requests through rt. It cannot be
+// combined with WithTLSConfig or WithProxy, which configure the default
+// transport.
+func WithTransport(rt http.RoundTripper) ClientOption {
+	return func(c *Client) error {
+		if rt == nil {
+			return errors.New("transport must not be nil")
+		}
+		c.transport = rt
+		return nil
+	}
+}
+
+// WithTimeout sets the timeout of a single HTTP request attempt. Zero means no
+// timeout.
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(c *Client) error {
+		if timeout < 0 {
+			return fmt.Errorf("timeout must not be negative, got: %s", timeout)
+		}
+		c.HTTPClient.Timeout = timeout
+		return nil
+	}
+}
+
+// WithProxy sends every request through the given proxy URL instead of the
+// proxy from the HTTP_PROXY/HTTPS_PROXY environment variables.
+func WithProxy(proxyURL *url.URL) ClientOption {
+	return func(c *Client) error {
+		if proxyURL == nil {
+			return errors.New("proxy URL must not be nil")
+		}
+		c.proxy = http.ProxyURL(proxyURL)
+		return nil
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *Client) error {
+		c.UserAgent = userAgent
+		return nil
+	}
+}
+
+// WithTLSConfig sets the TLS configuration of the default transport.
+func WithTLSConfig(tlsConfig *tls.Config) ClientOption {
+	return func(c *Client) error {
+		c.tlsConfig = tlsConfig
+		return nil
+	}
+}
+
+// WithRetry configures how failed requests are retried. A maxRetries of zero
+// disables retries.
+func WithRetry(maxRetries int, minWait, maxWait time.Duration) ClientOption {
+	return func(c *Client) error {
+		if maxRetries < 0 {
+			return fmt.Errorf("max retries must not be negative, got: %d", maxRetries)
+		}
+		if minWait < 0 || minWait > maxWait {
+			return fmt.Errorf("retry wait must satisfy 0 <= min (%s) <= max (%s)", minWait, maxWait)
+		}
+		c.MaxRetries = maxRetries
+		c.RetryMinWait = minWait
+		c.RetryMaxWait = maxWait
+		return nil
+	}
+}
+
+// WithToken authenticates every request with a bearer token.
+func WithToken(token string) ClientOption {
+	return func(c *Client) error {
+		c.Token = token
+		return nil
+	}
+}
+
+// WithBasicAuth authenticates every request with HTTP basic auth.
+func WithBasicAuth(username, password string) ClientOption {
+	return func(c *Client) error {
+		c.Username = username
+		c.Password = password
+		return nil
+	}
+}
+
+// WithHeaders adds the given headers to every request.
+func WithHeaders(headers map[string]string) ClientOption {
+	return func(c *Client) error {
+		if c.Headers == nil {
+			c.Headers = make(map[string]string, len(headers))
+		}
+		for name, value := range headers {
+			c.Headers[name] = value
+		}
+		return nil
+	}
+}
+
+// WithPageSize sets how many items are requested per page from list
+// endpoints. Zero lets the API choose.
+func WithPageSize(pageSize int) ClientOption {
+	return func(c *Client) error {
+		if pageSize < 0 {
+			return fmt.Errorf("page size must not be negative, got: %d", pageSize)
+		}
+		c.PageSize = pageSize
+		return nil
+	}
+}
+
+// WithStrictDecoding logs a warning for API responses with fields the client
+// does not know or without fields it expects.
+func WithStrictDecoding(strict bool) ClientOption {
+	return func(c *Client) error {
+		c.StrictDecoding = strict
+		return nil
+	}
+}
+
+// buildTransport returns the injected transport, or a clone of the default
+// transport with the configured TLS and proxy settings applied.
+func (c *Client) buildTransport() (http.RoundTripper, error) {
+	if c.transport != nil {
+		if c.tlsConfig != nil || c.proxy != nil {
+			return nil, errors.New("a custom transport cannot be combined with TLS or proxy options")
+		}
+		return c.transport, nil
+	}
+
+	transport := &http.Transport{Proxy: http.ProxyFromEnvironment}
+	if defaultTransport, ok := http.DefaultTransport.(*http.Transport); ok {
+		transport = defaultTransport.Clone()
+	}
+	if c.tlsConfig != nil {
+		transport.TLSClientConfig = c.tlsConfig
+	}
+	if c.proxy != nil {
+		transport.Proxy = c.proxy
+	}
+
+	return transport, nil
+}
+
+// RequestOption customizes a single API request.
+type RequestOption func(*http.Request)
+
+// WithIfMatch makes the request conditional on the resource still having the
+// entity tag etag, so it fails with 412 Precondition Failed instead of
+// overwriting a concurrent change. An empty etag leaves the request
+// unconditional.
+func WithIfMatch(etag string) RequestOption {
+	return func(req *http.Request) {
+		if etag != "" {
+			req.Header.Set("If-Match", etag)
+		}
+	}
+}
+
+func applyRequestOptions(req *http.Request, opts []RequestOption) {
+	for _, opt := range opts {
+		opt(req)
+	}
+}
@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+// roundTripFunc lets a plain function act as an http.RoundTripper.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestNewClient_transport(t *testing.T) {
+	var got *http.Request
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		got = req
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader(`[]`)),
+		}, nil
+	})
+
+	client, err := NewClient("http://bootcamp.invalid/", WithTransport(transport), WithUserAgent("bootcamp-test/1.0"), WithTimeout(time.Minute))
+	if err != nil {
+		t.Fatalf("unexpected error creating client: %s", err)
+	}
+
+	if _, err := client.GetEngineers(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got == nil {
+		t.Fatalf("expected request to go through the injected transport")
+	}
+	if got.URL.String() != "http://bootcamp.invalid/engineers" {
+		t.Errorf("unexpected URL: %s", got.URL)
+	}
+	if ua := got.Header.Get("User-Agent"); ua != "bootcamp-test/1.0" {
+		t.Errorf("expected User-Agent bootcamp-test/1.0, got: %s", ua)
+	}
+	if client.HTTPClient.Timeout != time.Minute {
+		t.Errorf("expected timeout of 1m, got: %s", client.HTTPClient.Timeout)
+	}
+}
+
+func TestNewClient_errors(t *testing.T) {
+	proxyURL, _ := url.Parse("http://proxy.invalid:3128")
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return nil, nil
+	})
+
+	testCases := map[string]struct {
+		endpoint string
+		opts     []ClientOption
+	}{
+		"empty-endpoint": {
+			endpoint: "",
+		},
+		"relative-endpoint": {
+			endpoint: "localhost",
+		},
+		"negative-timeout": {
+			endpoint: "http://localhost:8080",
+			opts:     []ClientOption{WithTimeout(-time.Second)},
+		},
+		"invalid-retry-wait": {
+			endpoint: "http://localhost:8080",
+			opts:     []ClientOption{WithRetry(3, time.Minute, time.Second)},
+		},
+		"transport-with-tls": {
+			endpoint: "http://localhost:8080",
+			opts:     []ClientOption{WithTransport(transport), WithTLSConfig(&tls.Config{MinVersion: tls.VersionTLS12})},
+		},
+		"transport-with-proxy": {
+			endpoint: "http://localhost:8080",
+			opts:     []ClientOption{WithTransport(transport), WithProxy(proxyURL)},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if _, err := NewClient(testCase.endpoint, testCase.opts...); err == nil {
+				t.Errorf("expected error, got none")
+			}
+		})
+	}
+}
+
+func TestWithIfMatch(t *testing.T) {
+	testCases := map[string]struct {
+		etag        string
+		wantIfMatch string
+		wantETag    string
+		wantStale   bool
+	}{
+		"unconditional": {
+			wantETag: `"2"`,
+		},
+		"current": {
+			etag:        `"1"`,
+			wantIfMatch: `"1"`,
+			wantETag:    `"2"`,
+		},
+		"stale": {
+			etag:        `"0"`,
+			wantIfMatch: `"0"`,
+			wantStale:   true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			api := newFakeAPIServer()
+			api.seed()
+			defer api.Close()
+
+			var gotIfMatch string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotIfMatch = r.Header.Get("If-Match")
+				api.Config.Handler.ServeHTTP(w, r)
+			}))
+			defer server.Close()
+
+			client, err := NewClient(server.URL)
+			if err != nil {
+				t.Fatalf("unexpected error creating client: %s", err)
+			}
+
+			engineer, err := client.UpdateEngineer(context.Background(), "00001", "Ryan", nil, WithIfMatch(testCase.etag))
+
+			if gotIfMatch != testCase.wantIfMatch {
+				t.Errorf("expected If-Match %q, got: %q", testCase.wantIfMatch, gotIfMatch)
+			}
+			if testCase.wantStale {
+				if !IsPreconditionFailed(err) {
+					t.Errorf("expected a precondition failed error, got: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if engineer.ETag != testCase.wantETag {
+				t.Errorf("expected entity tag %s, got: %s", testCase.wantETag, engineer.ETag)
+			}
+		})
+	}
+}
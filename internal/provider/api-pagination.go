@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// listPages requests the first page of a list endpoint and follows the
+// rel="next" Link header until the last page, calling fn with the items of
+// every page. An API without pagination returns a single page. Links leaving
+// the scheme and host of the endpoint are rejected, since every request
+// carries the client's credentials.
+func listPages[T any](c *Client, req *http.Request, fn func(page []T) error) error {
+	endpoint, err := url.Parse(c.Endpoint)
+	if err != nil {
+		return err
+	}
+
+	if c.PageSize > 0 {
+		query := req.URL.Query()
+		query.Set("limit", strconv.Itoa(c.PageSize))
+		req.URL.RawQuery = query.Encode()
+	}
+
+	seen := map[string]bool{}
+	for {
+		seen[req.URL.String()] = true
+
+		resp, body, err := c.do(req)
+		if err != nil {
+			return err
+		}
+
+		page := []T{}
+		if err := c.decode(req.Context(), body, &page); err != nil {
+			return err
+		}
+		if err := fn(page); err != nil {
+			return err
+		}
+
+		next := nextPageURL(resp)
+		if next == nil {
+			return nil
+		}
+		// Resolve relative links against the page they were served with
+		next = req.URL.ResolveReference(next)
+		if !sameOrigin(next, endpoint) {
+			return fmt.Errorf("next page link of %s points outside the API endpoint %s: %s", req.URL, c.Endpoint, next.Redacted())
+		}
+		if seen[next.String()] {
+			return fmt.Errorf("pagination loop: %s links back to %s", req.URL, next)
+		}
+
+		req, err = http.NewRequestWithContext(req.Context(), http.MethodGet, next.String(), nil)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// nextPageURL returns the target of the rel="next" Link header of resp, or nil
+// on the last page.
+func nextPageURL(resp *http.Response) *url.URL {
+	for _, header := range resp.Header.Values("Link") {
+		for _, link := range strings.Split(header, ",") {
+			target, params, found := strings.Cut(strings.TrimSpace(link), ";")
+			if !found || !strings.HasPrefix(target, "<") || !strings.HasSuffix(target, ">") {
+				continue
+			}
+
+			for _, param := range strings.Split(params, ";") {
+				name, value, _ := strings.Cut(strings.TrimSpace(param), "=")
+				if !strings.EqualFold(name, "rel") {
+					continue
+				}
+				for _, rel := range strings.Fields(strings.Trim(value, `"`)) {
+					if strings.EqualFold(rel, "next") {
+						next, err := url.Parse(target[1 : len(target)-1])
+						if err != nil {
+							return nil
+						}
+						return next
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// sameOrigin reports whether u has the scheme and host of endpoint.
+func sameOrigin(u, endpoint *url.URL) bool {
+	return strings.EqualFold(u.Scheme, endpoint.Scheme) && strings.EqualFold(u.Host, endpoint.Host)
+}
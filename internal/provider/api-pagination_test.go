@@ -0,0 +1,185 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestClientListEngineersPagination(t *testing.T) {
+	// Serve 5 engineers two at a time, linking pages with relative URLs
+	var limits []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		limits = append(limits, r.URL.Query().Get("limit"))
+		start, _ := strconv.Atoi(r.URL.Query().Get("cursor"))
+		end := min(start+2, 5)
+		if end < 5 {
+			w.Header().Set("Link", fmt.Sprintf(`</engineers?cursor=%d&limit=2>; rel="next"`, end))
+		}
+		page := []Engineer{}
+		for i := start; i < end; i++ {
+			page = append(page, Engineer{Id: strconv.Itoa(i)})
+		}
+		writeJSON(w, http.StatusOK, page)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL, WithPageSize(2))
+	if err != nil {
+		t.Fatalf("unexpected error creating client: %s", err)
+	}
+
+	engineers, err := client.GetEngineers(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	gotIds := []string{}
+	for _, engineer := range engineers {
+		gotIds = append(gotIds, engineer.Id)
+	}
+	if diff := cmp.Diff([]string{"0", "1", "2", "3", "4"}, gotIds); diff != "" {
+		t.Errorf("unexpected engineers difference: %s", diff)
+	}
+	if diff := cmp.Diff([]string{"2", "2", "2"}, limits); diff != "" {
+		t.Errorf("unexpected limits difference: %s", diff)
+	}
+}
+
+func TestClientListDevsPagesStop(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Link", `</dev?cursor=next>; rel="next"`)
+		writeJSON(w, http.StatusOK, []Dev{{Id: "1"}})
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error creating client: %s", err)
+	}
+
+	errStop := errors.New("stop")
+	err = client.ListDevsPages(context.Background(), DevFilter{}, func(page []Dev) error {
+		return errStop
+	})
+
+	if !errors.Is(err, errStop) {
+		t.Errorf("expected the callback error, got: %v", err)
+	}
+	if requests != 1 {
+		t.Errorf("expected 1 request, got: %d", requests)
+	}
+}
+
+func TestClientListPagesLoop(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Link", `</dev?limit=100>; rel="next"`)
+		writeJSON(w, http.StatusOK, []Dev{})
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error creating client: %s", err)
+	}
+
+	if _, err := client.GetDevs(context.Background()); err == nil {
+		t.Errorf("expected pagination loop error, got none")
+	}
+}
+
+func TestClientListPagesOtherHost(t *testing.T) {
+	var leaked http.Header
+	other := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		leaked = r.Header.Clone()
+		writeJSON(w, http.StatusOK, []Dev{})
+	}))
+	defer other.Close()
+
+	testCases := map[string]string{
+		"other-host":   other.URL + "/dev?cursor=2",
+		"other-scheme": "https://" + strings.TrimPrefix(other.URL, "http://") + "/dev?cursor=2",
+	}
+
+	for name, next := range testCases {
+		t.Run(name, func(t *testing.T) {
+			leaked = nil
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Link", fmt.Sprintf(`<%s>; rel="next"`, next))
+				writeJSON(w, http.StatusOK, []Dev{{Id: "1"}})
+			}))
+			defer server.Close()
+
+			client, err := NewClient(server.URL, WithToken("s3cr3t"))
+			if err != nil {
+				t.Fatalf("unexpected error creating client: %s", err)
+			}
+
+			if _, err := client.GetDevs(context.Background()); err == nil {
+				t.Errorf("expected an error for the next page link, got none")
+			}
+			if leaked != nil {
+				t.Errorf("expected no request to the other host, got one with Authorization %q", leaked.Get("Authorization"))
+			}
+		})
+	}
+}
+
+func TestNextPageURL(t *testing.T) {
+	testCases := map[string]struct {
+		link string
+		want string
+	}{
+		"none": {
+			link: "",
+			want: "",
+		},
+		"next": {
+			link: `<https://api.example.com/engineers?cursor=abc>; rel="next"`,
+			want: "https://api.example.com/engineers?cursor=abc",
+		},
+		"multiple-links": {
+			link: `</engineers?cursor=a>; rel="prev", </engineers?cursor=c>; rel="next"`,
+			want: "/engineers?cursor=c",
+		},
+		"multiple-rels": {
+			link: `</engineers?cursor=c>; title="more"; rel="last next"`,
+			want: "/engineers?cursor=c",
+		},
+		"only-prev": {
+			link: `</engineers?cursor=a>; rel=prev`,
+			want: "",
+		},
+		"malformed": {
+			link: `/engineers?cursor=c; rel="next"`,
+			want: "",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resp := &http.Response{Header: http.Header{}}
+			if testCase.link != "" {
+				resp.Header.Set("Link", testCase.link)
+			}
+
+			got := ""
+			if next := nextPageURL(resp); next != nil {
+				got = next.String()
+			}
+
+			if got != testCase.want {
+				t.Errorf("expected %q, got: %q", testCase.want, got)
+			}
+		})
+	}
+}
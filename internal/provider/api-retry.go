@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultMaxRetries   = 3
+	defaultRetryMinWait = 1 * time.Second
+	defaultRetryMaxWait = 30 * time.Second
+)
+
+// isIdempotent reports whether req can safely be sent more than once. POST
+// requests are when they carry an idempotency key, since the API then
+// answers a repeated request with the result of the first one.
+func isIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	case http.MethodPost:
+		return req.Header.Get(idempotencyKeyHeader) != ""
+	}
+
+	return false
+}
+
+// shouldRetry decides whether a response with the given status code is worth
+// another attempt. Rate limited requests were never processed by the API, so
+// they are retried for every method; transient gateway errors only for
+// idempotent ones.
+func shouldRetry(req *http.Request, statusCode int) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return isIdempotent(req)
+	}
+
+	return false
+}
+
+// backoff returns how long to wait before the given retry attempt (starting
+// at zero). It grows exponentially from RetryMinWait up to RetryMaxWait with
+// jitter, and honors a Retry-After header when the response carries one.
+func (c *Client) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			if wait > c.RetryMaxWait {
+				return c.RetryMaxWait
+			}
+			return wait
+		}
+	}
+
+	wait := c.RetryMinWait
+	for i := 0; i < attempt && wait < c.RetryMaxWait; i++ {
+		wait *= 2
+	}
+	if wait > c.RetryMaxWait {
+		wait = c.RetryMaxWait
+	}
+	if wait <= 0 {
+		return 0
+	}
+
+	// Equal jitter: keep half of the wait and randomize the other half so
+	// concurrent Terraform runs do not retry in lockstep.
+	half := wait / 2
+	return half + time.Duration(rand.Int63n(int64(wait-half)+1))
+}
+
+// parseRetryAfter understands both forms of the Retry-After header: a number
+// of seconds or an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+
+	return 0, false
+}
+
+// sleep waits for the given duration or until ctx is done.
+func sleep(ctx context.Context, wait time.Duration) error {
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestClientRetry(t *testing.T) {
+	testCases := map[string]struct {
+		method       string
+		statusCodes  []int
+		maxRetries   int
+		wantAttempts int32
+		wantErr      bool
+	}{
+		"get-recovers-from-503": {
+			method:       http.MethodGet,
+			statusCodes:  []int{http.StatusServiceUnavailable, http.StatusBadGateway, http.StatusOK},
+			maxRetries:   3,
+			wantAttempts: 3,
+		},
+		"get-gives-up": {
+			method:       http.MethodGet,
+			statusCodes:  []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable},
+			maxRetries:   2,
+			wantAttempts: 3,
+			wantErr:      true,
+		},
+		"post-retried-on-503-with-idempotency-key": {
+			method:       http.MethodPost,
+			statusCodes:  []int{http.StatusServiceUnavailable, http.StatusCreated},
+			maxRetries:   3,
+			wantAttempts: 2,
+		},
+		"post-retried-on-429": {
+			method:       http.MethodPost,
+			statusCodes:  []int{http.StatusTooManyRequests, http.StatusCreated},
+			maxRetries:   3,
+			wantAttempts: 2,
+		},
+		"not-found-not-retried": {
+			method:       http.MethodGet,
+			statusCodes:  []int{http.StatusNotFound, http.StatusOK},
+			maxRetries:   3,
+			wantAttempts: 1,
+			wantErr:      true,
+		},
+		"retries-disabled": {
+			method:       http.MethodGet,
+			statusCodes:  []int{http.StatusServiceUnavailable, http.StatusOK},
+			maxRetries:   0,
+			wantAttempts: 1,
+			wantErr:      true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var attempts int32
+			keys := map[string]bool{}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				keys[r.Header.Get("Idempotency-Key")] = true
+				attempt := atomic.AddInt32(&attempts, 1)
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(testCase.statusCodes[attempt-1])
+				_, _ = w.Write([]byte(`{}`))
+			}))
+			defer server.Close()
+
+			client, err := NewClient(server.URL)
+			if err != nil {
+				t.Fatalf("unexpected error creating client: %s", err)
+			}
+			client.MaxRetries = testCase.maxRetries
+
+			if testCase.method == http.MethodPost {
+				_, err = client.CreateEngineer(context.Background(), "Ryan", stringPointer("ryan@ferrets.com"))
+			} else {
+				_, err = client.GetEngineerById(context.Background(), "abc")
+			}
+
+			if testCase.wantErr && err == nil {
+				t.Errorf("expected error, got none")
+			}
+			if !testCase.wantErr && err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+			if got := atomic.LoadInt32(&attempts); got != testCase.wantAttempts {
+				t.Errorf("expected %d attempts, got: %d", testCase.wantAttempts, got)
+			}
+			// Every attempt of a POST carries the same idempotency key
+			if testCase.method == http.MethodPost && (len(keys) != 1 || keys[""]) {
+				t.Errorf("expected one idempotency key for all attempts, got: %v", keys)
+			}
+		})
+	}
+}
+
+func TestClientBackoff(t *testing.T) {
+	client := &Client{
+		RetryMinWait: 100 * time.Millisecond,
+		RetryMaxWait: 1 * time.Second,
+	}
+
+	for attempt, want := range []time.Duration{100, 200, 400, 800, 1000, 1000} {
+		want *= time.Millisecond
+		got := client.backoff(attempt, nil)
+		if got < want/2 || got > want {
+			t.Errorf("attempt %d: expected wait between %s and %s, got: %s", attempt, want/2, want, got)
+		}
+	}
+
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"120"}}}
+	if got := client.backoff(0, resp); got != client.RetryMaxWait {
+		t.Errorf("expected Retry-After to be capped at %s, got: %s", client.RetryMaxWait, got)
+	}
+
+	resp = &http.Response{Header: http.Header{"Retry-After": []string{"0"}}}
+	if got := client.backoff(3, resp); got != 0 {
+		t.Errorf("expected Retry-After of 0 to be honored, got: %s", got)
+	}
+}
+
+func TestClientRetryCancelled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "30")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error creating client: %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err = client.GetEngineers(ctx)
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected context.DeadlineExceeded, got: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("expected cancellation to interrupt the retry wait, took: %s", elapsed)
+	}
+}
@@ -0,0 +1,21 @@
+package provider
+
+import (
+	"crypto/x509"
+	"errors"
+)
+
+// newCertPool returns a pool with the system roots plus every certificate in
+// the given PEM bundle.
+func newCertPool(caPEM []byte) (*x509.CertPool, error) {
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+
+	if !pool.AppendCertsFromPEM(caPEM) {
+		return nil, errors.New("no valid PEM-encoded certificates found")
+	}
+
+	return pool, nil
+}
@@ -0,0 +1,207 @@
+package provider
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestClientTLSConfig(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	serverCAPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+
+	testCases := map[string]struct {
+		data          DevOpsAPIProviderModel
+		wantDiagErr   bool
+		wantRequest   bool
+		wantRequestOK bool
+	}{
+		"system-roots": {
+			wantRequest:   true,
+			wantRequestOK: false,
+		},
+		"ca-cert-pem": {
+			data:          DevOpsAPIProviderModel{CACertPEM: types.StringValue(serverCAPEM)},
+			wantRequest:   true,
+			wantRequestOK: true,
+		},
+		"insecure-skip-verify": {
+			data:          DevOpsAPIProviderModel{InsecureSkipVerify: types.BoolValue(true)},
+			wantRequest:   true,
+			wantRequestOK: true,
+		},
+		"invalid-ca-cert-pem": {
+			data:        DevOpsAPIProviderModel{CACertPEM: types.StringValue("not a certificate")},
+			wantDiagErr: true,
+		},
+		"missing-ca-cert-file": {
+			data:        DevOpsAPIProviderModel{CACertFile: types.StringValue(filepath.Join(t.TempDir(), "missing.pem"))},
+			wantDiagErr: true,
+		},
+		"unknown-ca-cert-pem": {
+			data:        DevOpsAPIProviderModel{CACertPEM: types.StringUnknown()},
+			wantDiagErr: true,
+		},
+		"unknown-ca-cert-file": {
+			data:        DevOpsAPIProviderModel{CACertFile: types.StringUnknown()},
+			wantDiagErr: true,
+		},
+		"unknown-client-cert": {
+			data: DevOpsAPIProviderModel{
+				ClientCert: types.StringUnknown(),
+				ClientKey:  types.StringValue("key"),
+			},
+			wantDiagErr: true,
+		},
+		"unknown-client-key": {
+			data:        DevOpsAPIProviderModel{ClientKey: types.StringUnknown()},
+			wantDiagErr: true,
+		},
+		"unknown-insecure-skip-verify": {
+			data:        DevOpsAPIProviderModel{InsecureSkipVerify: types.BoolUnknown()},
+			wantDiagErr: true,
+		},
+		"client-cert-without-key": {
+			data:        DevOpsAPIProviderModel{ClientCert: types.StringValue(serverCAPEM)},
+			wantDiagErr: true,
+		},
+		"invalid-client-key": {
+			data: DevOpsAPIProviderModel{
+				ClientCert: types.StringValue(serverCAPEM),
+				ClientKey:  types.StringValue("not a key"),
+			},
+			wantDiagErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			tlsConfig := clientTLSConfig(testCase.data, &diags)
+
+			if diags.HasError() != testCase.wantDiagErr {
+				t.Fatalf("expected error diagnostics to be %t, got: %v", testCase.wantDiagErr, diags)
+			}
+			if !testCase.wantRequest {
+				return
+			}
+
+			opts := []ClientOption{WithRetry(0, 0, 0)}
+			if tlsConfig != nil {
+				opts = append(opts, WithTLSConfig(tlsConfig))
+			}
+
+			client, err := NewClient(server.URL, opts...)
+			if err != nil {
+				t.Fatalf("unexpected error creating client: %s", err)
+			}
+
+			_, err = client.GetEngineers(context.Background())
+			if testCase.wantRequestOK && err != nil {
+				t.Errorf("unexpected request error: %s", err)
+			}
+			if !testCase.wantRequestOK && err == nil {
+				t.Errorf("expected request error, got none")
+			}
+		})
+	}
+}
+
+func TestClientTLSConfig_clientCertificate(t *testing.T) {
+	caCert, caKey := testCertificate(t, &x509.Certificate{
+		Subject:               pkix.Name{CommonName: "bootcamp test CA"},
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}, nil, nil)
+	clientCert, clientKey := testCertificate(t, &x509.Certificate{
+		Subject:     pkix.Name{CommonName: "terraform"},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+	}, caCert, caKey)
+
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(caCert)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCAs}
+	server.StartTLS()
+	defer server.Close()
+
+	clientKeyDER, err := x509.MarshalPKCS8PrivateKey(clientKey)
+	if err != nil {
+		t.Fatalf("unable to marshal client key: %s", err)
+	}
+
+	data := DevOpsAPIProviderModel{
+		CACertPEM:  types.StringValue(string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))),
+		ClientCert: types.StringValue(string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: clientCert.Raw}))),
+		ClientKey:  types.StringValue(string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: clientKeyDER}))),
+	}
+
+	var diags diag.Diagnostics
+	tlsConfig := clientTLSConfig(data, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	client, err := NewClient(server.URL, WithTLSConfig(tlsConfig))
+	if err != nil {
+		t.Fatalf("unexpected error creating client: %s", err)
+	}
+
+	if _, err := client.GetEngineers(context.Background()); err != nil {
+		t.Errorf("unexpected request error: %s", err)
+	}
+}
+
+// testCertificate creates a certificate from template, signed by parent, or
+// self-signed when parent is nil.
+func testCertificate(t *testing.T, template, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("unable to generate key: %s", err)
+	}
+
+	template.SerialNumber = big.NewInt(time.Now().UnixNano())
+	template.NotBefore = time.Now().Add(-time.Hour)
+	template.NotAfter = time.Now().Add(time.Hour)
+
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		t.Fatalf("unable to create certificate: %s", err)
+	}
+
+	certificate, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("unable to parse certificate: %s", err)
+	}
+
+	return certificate, key
+}
@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Engineer, Dev, Ops and DevOps are the documents exchanged with the API.
+// They are independent of the Terraform models, which are converted from and
+// to them in models.go. CreatedAt, UpdatedAt and Version are managed by the
+// API and only set in responses.
+
+// Engineer is an engineer. Email is nil for engineers without an email
+// address and then left out of requests.
+type Engineer struct {
+	Id    string  `json:"id,omitempty"`
+	Name  string  `json:"name"`
+	Email *string `json:"email,omitempty"`
+
+	CreatedAt *string `json:"created_at,omitempty"`
+	UpdatedAt *string `json:"updated_at,omitempty"`
+	Version   *int64  `json:"version,omitempty"`
+
+	// ETag is the entity tag of the response the engineer was read from, if
+	// the API sent one.
+	ETag string `json:"-"`
+}
+
+// emailAddress returns the email of the engineer, or "" when it has none.
+func (e Engineer) emailAddress() string {
+	if e.Email == nil {
+		return ""
+	}
+	return *e.Email
+}
+
+type Dev struct {
+	Id        string     `json:"id,omitempty"`
+	Name      string     `json:"name"`
+	Engineers []Engineer `json:"engineers"`
+
+	CreatedAt *string `json:"created_at,omitempty"`
+	UpdatedAt *string `json:"updated_at,omitempty"`
+	Version   *int64  `json:"version,omitempty"`
+
+	// ETag is the entity tag of the response the dev team was read from, if
+	// the API sent one.
+	ETag string `json:"-"`
+}
+
+type Ops struct {
+	Id        string     `json:"id,omitempty"`
+	Name      string     `json:"name"`
+	Engineers []Engineer `json:"engineers"`
+
+	CreatedAt *string `json:"created_at,omitempty"`
+	UpdatedAt *string `json:"updated_at,omitempty"`
+	Version   *int64  `json:"version,omitempty"`
+}
+
+// DevOps is a DevOps unit as returned by the API, with its full teams.
+type DevOps struct {
+	Id  string `json:"id,omitempty"`
+	Dev []Dev  `json:"dev"`
+	Ops []Ops  `json:"ops"`
+}
+
+// devOpsRequest is the body of DevOps create and update requests, which only
+// carry the ids of the teams.
+type devOpsRequest struct {
+	Dev []idRef `json:"dev"`
+	Ops []idRef `json:"ops"`
+}
+
+// idRef references an API object by its id.
+type idRef struct {
+	Id string `json:"id"`
+}
+
+// decode unmarshals an API response body into v. With strict decoding
+// enabled, fields of the response that v does not know and fields of v the
+// response lacks are logged, which helps spotting API changes.
+func (c *Client) decode(ctx context.Context, body []byte, v any) error {
+	if err := json.Unmarshal(body, v); err != nil {
+		return err
+	}
+
+	if !c.StrictDecoding {
+		return nil
+	}
+
+	var document any
+	if err := json.Unmarshal(body, &document); err != nil {
+		return err
+	}
+
+	var unknown, missing []string
+	compareFields(document, reflect.TypeOf(v), "", &unknown, &missing)
+	if len(unknown) > 0 || len(missing) > 0 {
+		tflog.Warn(ctx, "DevOps API response does not match the expected format", map[string]any{
+			"unknown_fields": unknown,
+			"missing_fields": missing,
+		})
+	}
+
+	return nil
+}
+
+// compareFields walks a generically decoded JSON document alongside the Go
+// type it was decoded into, collecting the paths of unknown fields and of
+// missing fields that are not tagged omitempty.
+func compareFields(document any, t reflect.Type, path string, unknown, missing *[]string) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.Slice, reflect.Array:
+		items, ok := document.([]any)
+		if !ok {
+			return
+		}
+		for i, item := range items {
+			compareFields(item, t.Elem(), fmt.Sprintf("%s[%d]", path, i), unknown, missing)
+		}
+	case reflect.Struct:
+		object, ok := document.(map[string]any)
+		if !ok {
+			return
+		}
+
+		known := map[string]bool{}
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			name, options, _ := strings.Cut(field.Tag.Get("json"), ",")
+			if !field.IsExported() || name == "-" {
+				continue
+			}
+			if name == "" {
+				name = field.Name
+			}
+			known[name] = true
+
+			value, ok := object[name]
+			if !ok {
+				if !strings.Contains(options, "omitempty") {
+					*missing = append(*missing, joinFieldPath(path, name))
+				}
+				continue
+			}
+			compareFields(value, field.Type, joinFieldPath(path, name), unknown, missing)
+		}
+
+		names := make([]string, 0, len(object))
+		for name := range object {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if !known[name] {
+				*unknown = append(*unknown, joinFieldPath(path, name))
+			}
+		}
+	}
+}
+
+func joinFieldPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
@@ -0,0 +1,216 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAPITypesMarshal(t *testing.T) {
+	testCases := map[string]struct {
+		value    any
+		expected string
+	}{
+		"engineer-without-id": {
+			value:    Engineer{Name: "Ryan", Email: stringPointer("ryan@ferrets.com")},
+			expected: `{"name":"Ryan","email":"ryan@ferrets.com"}`,
+		},
+		"engineer": {
+			value:    Engineer{Id: "00001", Name: "Ryan", Email: stringPointer("ryan@ferrets.com")},
+			expected: `{"id":"00001","name":"Ryan","email":"ryan@ferrets.com"}`,
+		},
+		"engineer-without-email": {
+			value:    Engineer{Id: "00001", Name: "Ryan"},
+			expected: `{"id":"00001","name":"Ryan"}`,
+		},
+		"engineer-empty-email": {
+			value:    Engineer{Id: "00001", Name: "Ryan", Email: stringPointer("")},
+			expected: `{"id":"00001","name":"Ryan","email":""}`,
+		},
+		"dev": {
+			value:    Dev{Name: "dev_ferrets", Engineers: []Engineer{{Id: "00001"}}},
+			expected: `{"name":"dev_ferrets","engineers":[{"id":"00001","name":""}]}`,
+		},
+		"devops": {
+			value:    DevOps{Dev: []Dev{{Id: "00004"}}, Ops: []Ops{}},
+			expected: `{"dev":[{"id":"00004","name":"","engineers":null}],"ops":[]}`,
+		},
+		"devops-request": {
+			value:    newDevOpsRequest([]string{"00004", "00005"}, []string{"00006"}),
+			expected: `{"dev":[{"id":"00004"},{"id":"00005"}],"ops":[{"id":"00006"}]}`,
+		},
+		"devops-request-empty": {
+			value:    newDevOpsRequest(nil, nil),
+			expected: `{"dev":[],"ops":[]}`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := json.Marshal(testCase.value)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(testCase.expected, string(got)); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestCompareFields(t *testing.T) {
+	testCases := map[string]struct {
+		body            string
+		value           any
+		expectedUnknown []string
+		expectedMissing []string
+	}{
+		"match": {
+			body:  `{"id":"00001","name":"Ryan","email":"ryan@ferrets.com"}`,
+			value: &Engineer{},
+		},
+		"omitempty-missing": {
+			body:  `{"name":"Ryan","email":"ryan@ferrets.com"}`,
+			value: &Engineer{},
+		},
+		"unknown": {
+			body:            `{"id":"00001","name":"Ryan","email":"ryan@ferrets.com","team":"ferrets","age":3}`,
+			value:           &Engineer{},
+			expectedUnknown: []string{"age", "team"},
+		},
+		"missing": {
+			body:            `{"id":"00001","Name":"Ryan"}`,
+			value:           &Engineer{},
+			expectedUnknown: []string{"Name"},
+			expectedMissing: []string{"name"},
+		},
+		"nested": {
+			body:            `[{"id":"00004","name":"dev_ferrets","engineers":[{"id":"00001"}]},{"id":"00005","name":"dev_bengal","engineers":[],"size":0}]`,
+			value:           &[]Dev{},
+			expectedUnknown: []string{"[1].size"},
+			expectedMissing: []string{"[0].engineers[0].name"},
+		},
+		"null-list": {
+			body:  `{"id":"00004","name":"dev_ferrets","engineers":null}`,
+			value: &Dev{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var document any
+			if err := json.Unmarshal([]byte(testCase.body), &document); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var unknown, missing []string
+			compareFields(document, reflect.TypeOf(testCase.value), "", &unknown, &missing)
+
+			if diff := cmp.Diff(testCase.expectedUnknown, unknown); diff != "" {
+				t.Errorf("unexpected unknown fields difference: %s", diff)
+			}
+			if diff := cmp.Diff(testCase.expectedMissing, missing); diff != "" {
+				t.Errorf("unexpected missing fields difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestEngineerModelConversion(t *testing.T) {
+	testCases := map[string]struct {
+		engineer Engineer
+		expected EngineerModel
+	}{
+		"email": {
+			engineer: Engineer{Id: "00001", Name: "Ryan", Email: stringPointer("ryan@ferrets.com")},
+			expected: EngineerModel{
+				Id:    types.StringValue("00001"),
+				Name:  types.StringValue("Ryan"),
+				Email: types.StringValue("ryan@ferrets.com"),
+			},
+		},
+		"no-email": {
+			engineer: Engineer{Id: "00001", Name: "Ryan"},
+			expected: EngineerModel{
+				Id:    types.StringValue("00001"),
+				Name:  types.StringValue("Ryan"),
+				Email: types.StringNull(),
+			},
+		},
+		"empty-email": {
+			engineer: Engineer{Id: "00001", Name: "Ryan", Email: stringPointer("")},
+			expected: EngineerModel{
+				Id:    types.StringValue("00001"),
+				Name:  types.StringValue("Ryan"),
+				Email: types.StringValue(""),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			model := newEngineerModel(testCase.engineer)
+			if diff := cmp.Diff(testCase.expected, model); diff != "" {
+				t.Errorf("unexpected model difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.engineer, model.toAPI()); diff != "" {
+				t.Errorf("unexpected engineer difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestClientDevOpsRequestBody(t *testing.T) {
+	testCases := map[string]struct {
+		send     func(*Client) error
+		expected string
+	}{
+		"create": {
+			send: func(client *Client) error {
+				_, err := client.CreateDevOps(context.Background(), []string{"00004"}, []string{"00006", "00007"})
+				return err
+			},
+			expected: `{"dev":[{"id":"00004"}],"ops":[{"id":"00006"},{"id":"00007"}]}`,
+		},
+		"update": {
+			send: func(client *Client) error {
+				_, err := client.UpdateDevOps(context.Background(), "00008", []string{}, []string{"00006"})
+				return err
+			},
+			expected: `{"dev":[],"ops":[{"id":"00006"}]}`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var got []byte
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got, _ = io.ReadAll(r.Body)
+				_, _ = w.Write([]byte(`{"id":"00008","dev":[],"ops":[]}`))
+			}))
+			defer server.Close()
+
+			client, err := NewClient(server.URL)
+			if err != nil {
+				t.Fatalf("unexpected error creating client: %s", err)
+			}
+
+			if err := testCase.send(client); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(testCase.expected, string(got)); diff != "" {
+				t.Errorf("unexpected request body difference: %s", diff)
+			}
+		})
+	}
+}
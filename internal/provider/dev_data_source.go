@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 func NewDevDataSource() datasource.DataSource {
@@ -15,12 +16,20 @@ func NewDevDataSource() datasource.DataSource {
 var _ datasource.DataSource = &DevDataSource{}
 
 type DevDataSourceModel struct {
-	Dev []DevModel `tfsdk:"dev"`
+	Dev    []DevModel                `tfsdk:"dev"`
+	Filter *DevDataSourceFilterModel `tfsdk:"filter"`
+}
+
+type DevDataSourceFilterModel struct {
+	NameRegex   types.String `tfsdk:"name_regex"`
+	EmailDomain types.String `tfsdk:"email_domain"`
+	Ids         types.Set    `tfsdk:"ids"`
+	HasEngineer types.String `tfsdk:"has_engineer"`
 }
 
 type DevModel struct {
-	Name      string          `tfsdk:"name"`
-	Id        string          `tfsdk:"id"`
+	Name      types.String    `tfsdk:"name"`
+	Id        types.String    `tfsdk:"id"`
 	Engineers []EngineerModel `tfsdk:"engineers"`
 }
 
@@ -65,6 +74,30 @@ func (d *DevDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, re
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"filter": schema.SingleNestedBlock{
+				MarkdownDescription: "Only return dev teams matching all of the given conditions.",
+				Attributes: map[string]schema.Attribute{
+					"name_regex": schema.StringAttribute{
+						MarkdownDescription: "Regular expression the team name must match.",
+						Optional:            true,
+					},
+					"email_domain": schema.StringAttribute{
+						MarkdownDescription: "Only return teams with at least one engineer in this email domain.",
+						Optional:            true,
+					},
+					"ids": schema.SetAttribute{
+						MarkdownDescription: "Ids of the teams to return.",
+						ElementType:         types.StringType,
+						Optional:            true,
+					},
+					"has_engineer": schema.StringAttribute{
+						MarkdownDescription: "Only return teams containing the engineer with this id.",
+						Optional:            true,
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -78,7 +111,20 @@ func (d *DevDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 	}
 
 	// Fetch Devs from the API
-	Devs, err := d.client.GetDevs()
+	var filter DevFilter
+	if data.Filter != nil {
+		filter = DevFilter{
+			NameRegex:   compileNameRegex(data.Filter.NameRegex, &resp.Diagnostics),
+			EmailDomain: data.Filter.EmailDomain.ValueString(),
+			Ids:         filterIds(ctx, data.Filter.Ids, &resp.Diagnostics),
+			HasEngineer: data.Filter.HasEngineer.ValueString(),
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	Devs, err := d.client.ListDevs(ctx, filter)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to fetch Devs",
@@ -87,7 +133,7 @@ func (d *DevDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 		return
 	}
 
-	data.Dev = Devs
+	data.Dev = newDevModels(Devs)
 
 	diags := resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
@@ -7,6 +7,8 @@ import (
 )
 
 func TestAccDevDataSource(t *testing.T) {
+	testAccFakeAPI(t)
+
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
@@ -23,3 +25,59 @@ func TestAccDevDataSource(t *testing.T) {
 		},
 	})
 }
+
+func TestAccDevDataSource_filter(t *testing.T) {
+	testAccFakeAPI(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "devops-bootcamp_dev" "by_engineer" {
+  filter {
+    has_engineer = "00001"
+  }
+}
+
+data "devops-bootcamp_dev" "by_name" {
+  filter {
+    name_regex = "bengal$"
+  }
+}
+
+data "devops-bootcamp_dev" "no_ids" {
+  filter {
+    ids = []
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.devops-bootcamp_dev.by_engineer", "dev.#", "1"),
+					resource.TestCheckResourceAttr("data.devops-bootcamp_dev.by_engineer", "dev.0.name", "dev_ferrets"),
+					resource.TestCheckResourceAttr("data.devops-bootcamp_dev.by_name", "dev.#", "1"),
+					resource.TestCheckResourceAttr("data.devops-bootcamp_dev.by_name", "dev.0.name", "dev_bengal"),
+					resource.TestCheckResourceAttr("data.devops-bootcamp_dev.no_ids", "dev.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDevDataSource_pagination(t *testing.T) {
+	testAccFakeAPI(t)
+	t.Setenv("BOOTCAMP_PAGE_SIZE", "1")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `data "devops-bootcamp_dev" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.devops-bootcamp_dev.test", "dev.#", "2"),
+					resource.TestCheckResourceAttr("data.devops-bootcamp_dev.test", "dev.1.name", "dev_bengal"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,209 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+func NewDevEngineerMembershipResource() resource.Resource {
+	return &DevEngineerMembershipResource{}
+}
+
+var _ resource.Resource = &DevEngineerMembershipResource{}
+var _ resource.ResourceWithImportState = &DevEngineerMembershipResource{}
+
+type DevEngineerMembershipResource struct {
+	client *Client
+}
+
+type DevEngineerMembershipResourceModel struct {
+	Id         types.String `tfsdk:"id"`
+	DevId      types.String `tfsdk:"dev_id"`
+	EngineerId types.String `tfsdk:"engineer_id"`
+}
+
+func (r *DevEngineerMembershipResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dev_engineer_membership"
+}
+
+func (r *DevEngineerMembershipResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Adds a single engineer to a dev team without managing the team's other members. " +
+			"Leave `engineer_ids` unset on the `dev_team` of teams whose members are managed with this resource.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Membership identifier in the form `dev_id/engineer_id`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dev_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the dev team.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"engineer_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the engineer.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *DevEngineerMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DevEngineerMembershipResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Add engineer to dev via API
+	_, err := r.client.AddDevEngineer(ctx, data.DevId.ValueString(), data.EngineerId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create dev engineer membership",
+			membershipErrorDetail("adding the engineer to the dev", err),
+		)
+		return
+	}
+
+	data.Id = types.StringValue(membershipId(data.DevId.ValueString(), data.EngineerId.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DevEngineerMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DevEngineerMembershipResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dev, err := r.client.GetDevById(ctx, data.DevId.ValueString())
+	if IsNotFound(err) {
+		tflog.Warn(ctx, "Dev not found, removing membership from state", map[string]any{"id": data.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to fetch dev",
+			"An error occurred while fetching the dev: "+err.Error(),
+		)
+		return
+	}
+
+	if !isDevMember(dev, data.EngineerId.ValueString()) {
+		tflog.Warn(ctx, "Engineer is no longer a member of the dev, removing membership from state", map[string]any{"id": data.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Id = types.StringValue(membershipId(data.DevId.ValueString(), data.EngineerId.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update only runs for changes that don't require replacement, of which there
+// are none; it keeps the planned values.
+func (r *DevEngineerMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DevEngineerMembershipResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DevEngineerMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DevEngineerMembershipResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Remove engineer from dev via API
+	_, err := r.client.RemoveDevEngineer(ctx, data.DevId.ValueString(), data.EngineerId.ValueString())
+	if err != nil && !IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to delete dev engineer membership",
+			membershipErrorDetail("removing the engineer from the dev", err),
+		)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *DevEngineerMembershipResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DevEngineerMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	devId, engineerId, found := strings.Cut(req.ID, "/")
+	if !found || devId == "" || engineerId == "" || strings.Contains(engineerId, "/") {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected an import ID in the form dev_id/engineer_id, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("dev_id"), devId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("engineer_id"), engineerId)...)
+}
+
+func membershipId(devId, engineerId string) string {
+	return devId + "/" + engineerId
+}
+
+func membershipErrorDetail(action string, err error) string {
+	if errors.Is(err, ErrConcurrentModification) {
+		return "Another client kept changing the members of the dev while " + action + ". " +
+			"Make sure the dev's engineer_ids are not managed elsewhere, then retry. Error: " + err.Error()
+	}
+	return "An error occurred while " + action + ": " + err.Error()
+}
@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDevEngineerMembershipResource(t *testing.T) {
+	testAccFakeAPI(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing, both memberships are applied in parallel
+			{
+				Config: providerConfig + `
+resource "devops-bootcamp_engineer-resource" "engineer1" {
+  name  = "John Doe"
+  email = "john.doe@example.com"
+}
+
+resource "devops-bootcamp_engineer-resource" "engineer2" {
+  name  = "Jane Smith"
+  email = "jane.smith@example.com"
+}
+
+resource "devops-bootcamp_dev_resource" "test" {
+  name = "Test Dev Group"
+}
+
+resource "devops-bootcamp_dev_engineer_membership" "engineer1" {
+  dev_id      = devops-bootcamp_dev_resource.test.id
+  engineer_id = devops-bootcamp_engineer-resource.engineer1.id
+}
+
+resource "devops-bootcamp_dev_engineer_membership" "engineer2" {
+  dev_id      = devops-bootcamp_dev_resource.test.id
+  engineer_id = devops-bootcamp_engineer-resource.engineer2.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("devops-bootcamp_dev_engineer_membership.engineer1", "dev_id", "devops-bootcamp_dev_resource.test", "id"),
+					resource.TestCheckResourceAttrPair("devops-bootcamp_dev_engineer_membership.engineer1", "engineer_id", "devops-bootcamp_engineer-resource.engineer1", "id"),
+					resource.TestCheckResourceAttrSet("devops-bootcamp_dev_engineer_membership.engineer1", "id"),
+				),
+			},
+			// The dev picks up both members on refresh
+			{
+				RefreshState: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devops-bootcamp_dev_resource.test", "engineer_ids.#", "2"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "devops-bootcamp_dev_engineer_membership.engineer1",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:  "devops-bootcamp_dev_engineer_membership.engineer1",
+				ImportState:   true,
+				ImportStateId: "00001",
+				ExpectError:   regexp.MustCompile(`Expected an import ID in the form dev_id/engineer_id`),
+			},
+			// Removing one membership keeps the other member
+			{
+				Config: providerConfig + `
+resource "devops-bootcamp_engineer-resource" "engineer1" {
+  name  = "John Doe"
+  email = "john.doe@example.com"
+}
+
+resource "devops-bootcamp_engineer-resource" "engineer2" {
+  name  = "Jane Smith"
+  email = "jane.smith@example.com"
+}
+
+resource "devops-bootcamp_dev_resource" "test" {
+  name = "Test Dev Group"
+}
+
+resource "devops-bootcamp_dev_engineer_membership" "engineer2" {
+  dev_id      = devops-bootcamp_dev_resource.test.id
+  engineer_id = devops-bootcamp_engineer-resource.engineer2.id
+}
+`,
+			},
+			{
+				RefreshState: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devops-bootcamp_dev_resource.test", "engineer_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("devops-bootcamp_dev_resource.test", "engineer_ids.*", "devops-bootcamp_engineer-resource.engineer2", "id"),
+				),
+			},
+			// Removing the last membership while renaming the dev doesn't
+			// write the removed member back
+			{
+				Config: providerConfig + `
+resource "devops-bootcamp_engineer-resource" "engineer1" {
+  name  = "John Doe"
+  email = "john.doe@example.com"
+}
+
+resource "devops-bootcamp_engineer-resource" "engineer2" {
+  name  = "Jane Smith"
+  email = "jane.smith@example.com"
+}
+
+resource "devops-bootcamp_dev_resource" "test" {
+  name = "Renamed Dev Group"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devops-bootcamp_dev_resource.test", "name", "Renamed Dev Group"),
+					resource.TestCheckResourceAttr("devops-bootcamp_dev_resource.test", "engineer_ids.#", "0"),
+				),
+			},
+			{
+				RefreshState: true,
+				Check:        resource.TestCheckResourceAttr("devops-bootcamp_dev_resource.test", "engineer_ids.#", "0"),
+			},
+		},
+	})
+}
+
+func TestAccDevEngineerMembershipResource_authoritativeConflict(t *testing.T) {
+	testAccFakeAPI(t)
+
+	config := func(name string, membership bool) string {
+		config := providerConfig + fmt.Sprintf(`
+resource "devops-bootcamp_dev_team" "test" {
+  name         = %q
+  engineer_ids = ["00001", "00002"]
+}
+`, name)
+		if membership {
+			config += `
+resource "devops-bootcamp_dev_engineer_membership" "test" {
+  dev_id      = devops-bootcamp_dev_team.test.id
+  engineer_id = "00002"
+}
+`
+		}
+		return config
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("Shared Dev Group", true),
+			},
+			// The removed membership conflicts with the authoritative member
+			// list, so writing that list back must not silently re-add it
+			{
+				Config:      config("Renamed Dev Group", false),
+				ExpectError: regexp.MustCompile(`Resource changed outside this run`),
+			},
+			// After a refresh the member list applies on top of the removal
+			{
+				Config: config("Renamed Dev Group", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devops-bootcamp_dev_team.test", "name", "Renamed Dev Group"),
+					resource.TestCheckResourceAttr("devops-bootcamp_dev_team.test", "engineer_ids.#", "2"),
+				),
+			},
+		},
+	})
+}
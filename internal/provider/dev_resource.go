@@ -2,65 +2,68 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"slices"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 func NewDevResource() resource.Resource {
 	return &DevResource{}
 }
 
+// NewLegacyDevResource returns the resource under its deprecated
+// _dev_resource type name.
+func NewLegacyDevResource() resource.Resource {
+	return &DevResource{legacy: true}
+}
+
 var _ resource.Resource = &DevResource{}
+var _ resource.ResourceWithUpgradeState = &DevResource{}
+var _ resource.ResourceWithMoveState = &DevResource{}
+var _ resource.ResourceWithModifyPlan = &DevResource{}
 
 type DevResource struct {
 	client *Client
+
+	// legacy registers the resource under its deprecated type name.
+	legacy bool
 }
 
 type DevResourceModel struct {
-	Name      types.String    `tfsdk:"name"`
-	Id        types.String    `tfsdk:"id"`
-	Engineers []EngineerModel `tfsdk:"engineers"`
+	Name        types.String `tfsdk:"name"`
+	Id          types.String `tfsdk:"id"`
+	EngineerIds types.Set    `tfsdk:"engineer_ids"`
+	Engineers   types.Set    `tfsdk:"engineers"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	UpdatedAt   types.String `tfsdk:"updated_at"`
+	Version     types.Int64  `tfsdk:"version"`
+
+	AdoptExisting types.Bool `tfsdk:"adopt_existing"`
 }
 
 func (r *DevResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_dev_resource"
+	if r.legacy {
+		resp.TypeName = req.ProviderTypeName + "_dev_resource"
+		return
+	}
+	resp.TypeName = req.ProviderTypeName + "_dev_team"
 }
 
 func (r *DevResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		Attributes: map[string]schema.Attribute{
-			"name": schema.StringAttribute{
-				Required: true,
-			},
-			"id": schema.StringAttribute{
-				Computed: true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
-				},
-			},
-			"engineers": schema.ListNestedAttribute{
-				Optional: true,
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"name": schema.StringAttribute{
-							Optional: true,
-						},
-						"id": schema.StringAttribute{
-							Optional: true,
-						},
-						"email": schema.StringAttribute{
-							Optional: true,
-						},
-					},
-				},
-			},
-		},
+	resp.Schema = devResourceSchema()
+	if r.legacy {
+		resp.Schema.DeprecationMessage = "Use devops-bootcamp_dev_team instead, moving existing resources with a moved block. " +
+			"The devops-bootcamp_dev_resource type will be removed in the next major version."
 	}
 }
 
@@ -73,30 +76,40 @@ func (r *DevResource) Create(ctx context.Context, req resource.CreateRequest, re
 		return
 	}
 
-	// Create Dev via API
-	dev, err := r.client.CreateDev(data.Name.ValueString(), data.Engineers)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to create dev",
-			"An error occurred while creating the dev: "+err.Error(),
-		)
+	engineers := r.plannedEngineers(ctx, data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	data.Id = types.StringValue(dev.Id)
-	data.Name = types.StringValue(dev.Name)
-	data.Engineers = make([]EngineerModel, len(dev.Engineers))
-	for i, engineer := range dev.Engineers {
-		data.Engineers[i].Name = engineer.Name
-		data.Engineers[i].Id = engineer.Id
-		data.Engineers[i].Email = engineer.Email
+	// Only set when configured, so imported and created resources match
+	if data.AdoptExisting.IsUnknown() {
+		data.AdoptExisting = types.BoolNull()
 	}
 
-	diags := resp.State.Set(ctx, &data)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
+	var dev *Dev
+	if data.AdoptExisting.ValueBool() {
+		dev = r.adoptExisting(ctx, data, engineers, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Create Dev via API, unless an existing one was adopted
+	if dev == nil {
+		var err error
+		dev, err = r.client.CreateDev(ctx, data.Name.ValueString(), engineers)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to create dev",
+				"An error occurred while creating the dev: "+err.Error(),
+			)
+			return
+		}
 	}
+
+	resp.Diagnostics.Append(data.refresh(ctx, dev)...)
+	setETag(ctx, resp.Private, dev.ETag, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *DevResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -109,7 +122,12 @@ func (r *DevResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	}
 
 	// Fetch dev from the API using GetDevById
-	dev, err := r.client.GetDevById(data.Id.ValueString())
+	dev, err := r.client.GetDevById(ctx, data.Id.ValueString())
+	if IsNotFound(err) {
+		tflog.Warn(ctx, "Dev not found, removing from state", map[string]any{"id": data.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to fetch dev",
@@ -118,20 +136,20 @@ func (r *DevResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 		return
 	}
 
-	data.Id = types.StringValue(dev.Id)
-	data.Name = types.StringValue(dev.Name)
-	data.Engineers = make([]EngineerModel, len(dev.Engineers))
-	for i, engineer := range dev.Engineers {
-		data.Engineers[i].Name = engineer.Name
-		data.Engineers[i].Id = engineer.Id
-		data.Engineers[i].Email = engineer.Email
-	}
-
-	diags := resp.State.Set(ctx, &data)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	// The dev team stores copies of its engineers, refresh them so renamed
+	// engineers don't show up with their old details
+	err = r.refreshEngineers(ctx, dev)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to fetch dev engineers",
+			"An error occurred while fetching the engineers of the dev: "+err.Error(),
+		)
 		return
 	}
+
+	resp.Diagnostics.Append(data.refresh(ctx, dev)...)
+	setETag(ctx, resp.Private, dev.ETag, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *DevResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -143,30 +161,61 @@ func (r *DevResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		return
 	}
 
-	// Update dev via API
-	dev, err := r.client.UpdateDev(data.Id.ValueString(), data.Name.ValueString(), data.Engineers)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to update dev",
-			"An error occurred while updating the dev: "+err.Error(),
-		)
+	var state DevResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	data.Id = types.StringValue(dev.Id)
-	data.Name = types.StringValue(dev.Name)
-	data.Engineers = make([]EngineerModel, len(dev.Engineers))
-	for i, engineer := range dev.Engineers {
-		data.Engineers[i].Name = engineer.Name
-		data.Engineers[i].Id = engineer.Id
-		data.Engineers[i].Email = engineer.Email
+	// Only adopt_existing changed, which the API doesn't know about
+	if data.Name.Equal(state.Name) && data.EngineerIds.Equal(state.EngineerIds) {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
 	}
 
-	diags := resp.State.Set(ctx, &data)
-	resp.Diagnostics.Append(diags...)
+	engineers := r.plannedEngineers(ctx, data, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// Update dev via API, unless it changed since it was last read
+	etag := getETag(ctx, req.Private, &resp.Diagnostics)
+
+	// Without engineer_ids in the configuration the members are managed
+	// elsewhere, for example by membership resources of this run, so keep the
+	// current ones instead of writing back those last read. Since they are
+	// kept, membership changes of this run don't count as outside changes.
+	if data.EngineerIds.IsUnknown() {
+		defer lockDev(data.Id.ValueString())()
+
+		current, err := r.client.GetDevById(ctx, data.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to fetch dev",
+				"An error occurred while fetching the dev: "+err.Error(),
+			)
+			return
+		}
+		engineers = current.Engineers
+		etag = r.client.currentDevETag(data.Id.ValueString(), etag)
+	}
+
+	dev, err := r.client.UpdateDev(ctx, data.Id.ValueString(), data.Name.ValueString(), engineers, WithIfMatch(etag))
+	if IsPreconditionFailed(err) {
+		addChangedOutsideError(&resp.Diagnostics, "dev team", data.Id.ValueString(), "updated")
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to update dev",
+			"An error occurred while updating the dev: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(data.refresh(ctx, dev)...)
+	setETag(ctx, resp.Private, dev.ETag, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *DevResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -178,9 +227,15 @@ func (r *DevResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 		return
 	}
 
-	// Delete dev via API
-	err := r.client.DeleteDev(data.Id.ValueString())
-	if err != nil {
+	// Delete dev via API, unless it changed since it was last read other than
+	// through membership resources of this run, which the delete doesn't undo
+	etag := r.client.currentDevETag(data.Id.ValueString(), getETag(ctx, req.Private, &resp.Diagnostics))
+	err := r.client.DeleteDev(ctx, data.Id.ValueString(), WithIfMatch(etag))
+	if IsPreconditionFailed(err) {
+		addChangedOutsideError(&resp.Diagnostics, "dev team", data.Id.ValueString(), "deleted")
+		return
+	}
+	if err != nil && !IsNotFound(err) {
 		resp.Diagnostics.AddError(
 			"Unable to delete dev",
 			"An error occurred while deleting the dev: "+err.Error(),
@@ -191,6 +246,39 @@ func (r *DevResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 	resp.State.RemoveResource(ctx)
 }
 
+// ModifyPlan keeps the prior adopt_existing, which only matters on create,
+// unless it is configured. When nothing else changed it keeps the rest of the
+// prior state, so changing the flag only plans an update of the flag itself.
+func (r *DevResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to keep on create and destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state DevResourceModel
+	var configEngineerIds types.Set
+	var configAdoptExisting types.Bool
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("engineer_ids"), &configEngineerIds)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("adopt_existing"), &configAdoptExisting)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	adoptExisting := state.AdoptExisting
+	if !configAdoptExisting.IsNull() {
+		adoptExisting = configAdoptExisting
+	}
+
+	// Unconfigured engineer_ids are unknown in the plan but don't change
+	engineerIdsKept := configEngineerIds.IsNull() || plan.EngineerIds.Equal(state.EngineerIds)
+	if plan.Name.Equal(state.Name) && engineerIdsKept {
+		resp.Plan.Raw = req.State.Raw
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("adopt_existing"), adoptExisting)...)
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *DevResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Add a nil check when handling ProviderData because Terraform
@@ -216,3 +304,417 @@ func (r *DevResource) ImportState(ctx context.Context, req resource.ImportStateR
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+func (r *DevResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(devResourceSchema(), devResourceMigrations())
+}
+
+// MoveState accepts moved blocks from the deprecated dev_resource type.
+func (r *DevResource) MoveState(_ context.Context) []resource.StateMover {
+	if r.legacy {
+		return nil
+	}
+	return []resource.StateMover{
+		stateMover("devops-bootcamp_dev_resource", devResourceSchema(), devResourceMigrations()),
+	}
+}
+
+// adoptExisting takes over the dev team with the planned name and updates it
+// to the planned engineers. When engineer_ids isn't configured the team keeps
+// its current engineers. It returns nil when no dev team has the name.
+func (r *DevResource) adoptExisting(ctx context.Context, data DevResourceModel, engineers []Engineer, diags *diag.Diagnostics) *Dev {
+	matches, err := r.client.FindDevsByName(ctx, data.Name.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Unable to fetch Devs",
+			"An error occurred while fetching Devs: "+err.Error(),
+		)
+		return nil
+	}
+	switch {
+	case len(matches) == 0:
+		return nil
+	case len(matches) > 1:
+		ids := make([]string, len(matches))
+		for i, dev := range matches {
+			ids[i] = dev.Id
+		}
+		diags.AddAttributeError(
+			path.Root("name"),
+			"Multiple dev teams found",
+			fmt.Sprintf("%d dev teams are named %q (ids: %s), so none of them can be adopted.", len(matches), data.Name.ValueString(), strings.Join(ids, ", ")),
+		)
+		return nil
+	}
+
+	// Read the dev team for its entity tag, so the update doesn't overwrite
+	// a change made since it was found
+	existing, err := r.client.GetDevById(ctx, matches[0].Id)
+	if err != nil {
+		diags.AddError(
+			"Unable to fetch dev",
+			"An error occurred while fetching the dev: "+err.Error(),
+		)
+		return nil
+	}
+	if data.EngineerIds.IsUnknown() {
+		engineers = existing.Engineers
+	}
+
+	dev, err := r.client.UpdateDev(ctx, existing.Id, data.Name.ValueString(), engineers, WithIfMatch(existing.ETag))
+	if IsPreconditionFailed(err) {
+		addChangedOutsideError(diags, "dev team", existing.Id, "adopted")
+		return nil
+	}
+	if err != nil {
+		diags.AddError(
+			"Unable to adopt dev",
+			"An error occurred while updating the existing dev: "+err.Error(),
+		)
+		return nil
+	}
+
+	diags.AddWarning(
+		"Adopted existing dev team",
+		fmt.Sprintf("The dev team %s (name %q, %d engineers) already existed, so it was taken over instead of creating a new one and updated to the configuration. "+
+			"Terraform now manages it and deletes it on destroy.", existing.Id, existing.Name, len(existing.Engineers)),
+	)
+
+	return dev
+}
+
+// plannedEngineers looks up the engineers planned in engineer_ids, so the dev
+// team is saved with their current details.
+func (r *DevResource) plannedEngineers(ctx context.Context, data DevResourceModel, diags *diag.Diagnostics) []Engineer {
+	ids := []string{}
+	if !data.EngineerIds.IsNull() && !data.EngineerIds.IsUnknown() {
+		diags.Append(data.EngineerIds.ElementsAs(ctx, &ids, false)...)
+	}
+	if diags.HasError() || len(ids) == 0 {
+		return []Engineer{}
+	}
+
+	found, err := r.client.ListEngineers(ctx, EngineerFilter{Ids: ids})
+	if err != nil {
+		diags.AddError(
+			"Unable to fetch engineers",
+			"An error occurred while fetching engineers: "+err.Error(),
+		)
+		return nil
+	}
+
+	byId := make(map[string]Engineer, len(found))
+	for _, engineer := range found {
+		byId[engineer.Id] = engineer
+	}
+
+	engineers := make([]Engineer, 0, len(ids))
+	var missing []string
+	for _, id := range ids {
+		engineer, ok := byId[id]
+		if !ok {
+			missing = append(missing, id)
+			continue
+		}
+		engineers = append(engineers, engineer)
+	}
+
+	if len(missing) > 0 {
+		diags.AddAttributeError(
+			path.Root("engineer_ids"),
+			"Engineers not found",
+			"The following engineers do not exist: "+strings.Join(missing, ", "),
+		)
+		return nil
+	}
+
+	return engineers
+}
+
+// refreshEngineers replaces the engineer copies stored in dev with their
+// current details. Engineers that no longer exist keep their stored copy.
+func (r *DevResource) refreshEngineers(ctx context.Context, dev *Dev) error {
+	if len(dev.Engineers) == 0 {
+		return nil
+	}
+
+	ids := make([]string, len(dev.Engineers))
+	for i, engineer := range dev.Engineers {
+		ids[i] = engineer.Id
+	}
+
+	found, err := r.client.ListEngineers(ctx, EngineerFilter{Ids: ids})
+	if err != nil {
+		return err
+	}
+
+	byId := make(map[string]Engineer, len(found))
+	for _, engineer := range found {
+		byId[engineer.Id] = engineer
+	}
+	for i, engineer := range dev.Engineers {
+		if current, ok := byId[engineer.Id]; ok {
+			dev.Engineers[i] = current
+		}
+	}
+
+	return nil
+}
+
+// refresh copies the API representation of a dev team into the model.
+// Create, Read, Update and Import all go through it, so they store the same
+// state for the same dev team.
+func (m *DevResourceModel) refresh(ctx context.Context, dev *Dev) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// The API may list an engineer twice, which a set cannot hold
+	ids := []string{}
+	engineers := []Engineer{}
+	for _, engineer := range dev.Engineers {
+		if !slices.Contains(ids, engineer.Id) {
+			ids = append(ids, engineer.Id)
+			engineers = append(engineers, engineer)
+		}
+	}
+
+	m.Id = types.StringValue(dev.Id)
+	m.Name = types.StringValue(dev.Name)
+	m.CreatedAt = types.StringPointerValue(dev.CreatedAt)
+	m.UpdatedAt = types.StringPointerValue(dev.UpdatedAt)
+	m.Version = types.Int64PointerValue(dev.Version)
+
+	engineerIds, d := types.SetValueFrom(ctx, types.StringType, ids)
+	diags.Append(d...)
+	m.EngineerIds = engineerIds
+
+	engineerSet, d := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: engineerAttrTypes}, newEngineerModels(engineers))
+	diags.Append(d...)
+	m.Engineers = engineerSet
+
+	return diags
+}
+
+func devResourceSchema() schema.Schema {
+	return schema.Schema{
+		Version: 3,
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"engineer_ids": schema.SetAttribute{
+				MarkdownDescription: "Ids of the engineers in the dev team.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+			},
+			"engineers": schema.SetNestedAttribute{
+				MarkdownDescription: "Current details of the engineers in the dev team.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"email": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Time the dev team was created, set by the API.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Time the dev team was last changed, set by the API.",
+				Computed:            true,
+			},
+			"version": schema.Int64Attribute{
+				MarkdownDescription: "Revision of the dev team, incremented by the API on every change.",
+				Computed:            true,
+			},
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over an existing dev team with the same name instead of creating a new one. " +
+					"Only applies on create, changing it later has no effect. Without `engineer_ids` the team keeps its current engineers.",
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func devResourceMigrations() []stateMigration {
+	return []stateMigration{
+		{priorSchema: devResourceSchemaV0(), migrate: migrateDevStateV0},
+		{priorSchema: devResourceSchemaV1(), migrate: migrateDevStateV1},
+		{priorSchema: devResourceSchemaV2(), migrate: migrateDevStateV2},
+	}
+}
+
+// devResourceSchemaV0 embedded full engineer objects as the managed input.
+func devResourceSchemaV0() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"engineers": schema.ListNestedAttribute{
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Optional: true,
+						},
+						"id": schema.StringAttribute{
+							Optional: true,
+						},
+						"email": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// devResourceSchemaV1 managed engineer_ids and stored the computed engineers
+// as a list.
+func devResourceSchemaV1() schema.Schema {
+	return schema.Schema{
+		Version: 1,
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"engineer_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+			},
+			"engineers": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"email": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// devResourceSchemaV2 had no server-managed attributes.
+func devResourceSchemaV2() schema.Schema {
+	return schema.Schema{
+		Version: 2,
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"engineer_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+			},
+			"engineers": schema.SetNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"email": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// migrateDevStateV0 derives engineer_ids from the embedded engineers.
+func migrateDevStateV0(_ context.Context, state map[string]any) error {
+	engineers, _ := state["engineers"].([]any)
+
+	ids := []any{}
+	for _, engineer := range engineers {
+		engineer, _ := engineer.(map[string]any)
+		if id, ok := engineer["id"].(string); ok && !slices.Contains(ids, any(id)) {
+			ids = append(ids, id)
+		}
+	}
+
+	state["engineer_ids"] = ids
+	if engineers == nil {
+		state["engineers"] = []any{}
+	}
+
+	return nil
+}
+
+// migrateDevStateV1 drops repeated engineers, which the version 1 list allowed
+// but the version 2 set cannot hold.
+func migrateDevStateV1(_ context.Context, state map[string]any) error {
+	engineers, _ := state["engineers"].([]any)
+
+	unique := []any{}
+	seen := map[string]bool{}
+	for _, engineer := range engineers {
+		key, err := json.Marshal(engineer)
+		if err != nil {
+			return err
+		}
+		if !seen[string(key)] {
+			seen[string(key)] = true
+			unique = append(unique, engineer)
+		}
+	}
+
+	state["engineers"] = unique
+
+	return nil
+}
+
+// migrateDevStateV2 adds the server-managed attributes as null. The next
+// refresh fills them in.
+func migrateDevStateV2(_ context.Context, state map[string]any) error {
+	state["created_at"] = nil
+	state["updated_at"] = nil
+	state["version"] = nil
+
+	return nil
+}
@@ -1,12 +1,17 @@
 package provider
 
 import (
+	"context"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 )
 
 func TestAccDevResource(t *testing.T) {
+	testAccFakeAPI(t)
+
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
@@ -25,9 +30,9 @@ resource "devops-bootcamp_engineer-resource" "engineer2" {
 
 resource "devops-bootcamp_dev_resource" "test" {
   name = "Test Dev Group"
-  engineers = [
-    devops-bootcamp_engineer-resource.engineer1,
-    devops-bootcamp_engineer-resource.engineer2
+  engineer_ids = [
+    devops-bootcamp_engineer-resource.engineer1.id,
+    devops-bootcamp_engineer-resource.engineer2.id
   ]
 }
 `,
@@ -42,6 +47,16 @@ resource "devops-bootcamp_dev_resource" "test" {
 					resource.TestCheckResourceAttrSet("devops-bootcamp_engineer-resource.engineer2", "id"),
 					// Verify attributes for dev resource
 					resource.TestCheckResourceAttrSet("devops-bootcamp_dev_resource.test", "id"),
+					resource.TestCheckResourceAttrSet("devops-bootcamp_dev_resource.test", "created_at"),
+					resource.TestCheckResourceAttrSet("devops-bootcamp_dev_resource.test", "updated_at"),
+					resource.TestCheckResourceAttr("devops-bootcamp_dev_resource.test", "version", "1"),
+					resource.TestCheckResourceAttr("devops-bootcamp_dev_resource.test", "engineer_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair("devops-bootcamp_dev_resource.test", "engineer_ids.*", "devops-bootcamp_engineer-resource.engineer1", "id"),
+					resource.TestCheckResourceAttr("devops-bootcamp_dev_resource.test", "engineers.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("devops-bootcamp_dev_resource.test", "engineers.*", map[string]string{
+						"name":  "Jane Smith",
+						"email": "jane.smith@example.com",
+					}),
 				),
 			},
 			// ImportState testing
@@ -65,13 +80,343 @@ resource "devops-bootcamp_engineer-resource" "engineer2" {
 
 resource "devops-bootcamp_dev_resource" "test" {
   name = "Updated Test Dev Group"
-  engineers = [
-    devops-bootcamp_engineer-resource.engineer1,
-    devops-bootcamp_engineer-resource.engineer2
+  engineer_ids = [
+    devops-bootcamp_engineer-resource.engineer1.id,
+    devops-bootcamp_engineer-resource.engineer2.id
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devops-bootcamp_dev_resource.test", "name", "Updated Test Dev Group"),
+					resource.TestCheckResourceAttr("devops-bootcamp_dev_resource.test", "version", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("devops-bootcamp_dev_resource.test", "engineers.*", map[string]string{
+						"name": "Updated Jane Smith",
+					}),
+				),
+			},
+			// Renaming an engineer without touching the dev is picked up on refresh
+			{
+				Config: providerConfig + `
+resource "devops-bootcamp_engineer-resource" "engineer1" {
+  name  = "Renamed John Doe"
+  email = "john.doe@example.com"
+}
+
+resource "devops-bootcamp_engineer-resource" "engineer2" {
+  name  = "Updated Jane Smith"
+  email = "jane.smith@example.com"
+}
+
+resource "devops-bootcamp_dev_resource" "test" {
+  name = "Updated Test Dev Group"
+  engineer_ids = [
+    devops-bootcamp_engineer-resource.engineer1.id,
+    devops-bootcamp_engineer-resource.engineer2.id
   ]
 }
 `,
 			},
+			{
+				RefreshState: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devops-bootcamp_dev_resource.test", "engineers.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("devops-bootcamp_dev_resource.test", "engineers.*", map[string]string{
+						"name": "Renamed John Doe",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDevResource_missingEngineer(t *testing.T) {
+	testAccFakeAPI(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "devops-bootcamp_dev_resource" "test" {
+  name         = "Test Dev Group"
+  engineer_ids = ["00001", "99999"]
+}
+`,
+				ExpectError: regexp.MustCompile(`The following engineers do not exist: 99999`),
+			},
+		},
+	})
+}
+
+func TestAccDevResource_adoptExisting(t *testing.T) {
+	testAccFakeAPI(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Adopted teams take the configured engineers, or keep their own
+			{
+				Config: providerConfig + `
+resource "devops-bootcamp_dev_team" "bengal" {
+  name           = "dev_bengal"
+  engineer_ids   = ["00002"]
+  adopt_existing = true
+}
+
+resource "devops-bootcamp_dev_team" "ferrets" {
+  name           = "dev_ferrets"
+  adopt_existing = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devops-bootcamp_dev_team.bengal", "id", "00005"),
+					resource.TestCheckResourceAttr("devops-bootcamp_dev_team.bengal", "engineer_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr("devops-bootcamp_dev_team.bengal", "engineer_ids.*", "00002"),
+					resource.TestCheckResourceAttr("devops-bootcamp_dev_team.bengal", "version", "2"),
+					resource.TestCheckResourceAttr("devops-bootcamp_dev_team.ferrets", "id", "00004"),
+					resource.TestCheckResourceAttr("devops-bootcamp_dev_team.ferrets", "engineer_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr("devops-bootcamp_dev_team.ferrets", "engineer_ids.*", "00001"),
+				),
+			},
+			// Changing the flag after create only updates the flag, removing it
+			// keeps the prior value
+			{
+				Config: providerConfig + `
+resource "devops-bootcamp_dev_team" "bengal" {
+  name         = "dev_bengal"
+  engineer_ids = ["00002"]
+}
+
+resource "devops-bootcamp_dev_team" "ferrets" {
+  name           = "dev_ferrets"
+  adopt_existing = false
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("devops-bootcamp_dev_team.bengal", plancheck.ResourceActionNoop),
+						plancheck.ExpectResourceAction("devops-bootcamp_dev_team.ferrets", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devops-bootcamp_dev_team.bengal", "adopt_existing", "true"),
+					resource.TestCheckResourceAttr("devops-bootcamp_dev_team.bengal", "version", "2"),
+					resource.TestCheckResourceAttr("devops-bootcamp_dev_team.ferrets", "adopt_existing", "false"),
+					resource.TestCheckResourceAttr("devops-bootcamp_dev_team.ferrets", "version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDevResource_adoptExistingLater(t *testing.T) {
+	testAccFakeAPI(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create without the flag
+			{
+				Config: providerConfig + `
+resource "devops-bootcamp_dev_team" "created" {
+  name         = "dev_new"
+  engineer_ids = ["00003"]
+}
+`,
+				Check: resource.TestCheckNoResourceAttr("devops-bootcamp_dev_team.created", "adopt_existing"),
+			},
+			// Setting the flag later only updates the flag
+			{
+				Config: providerConfig + `
+resource "devops-bootcamp_dev_team" "created" {
+  name           = "dev_new"
+  engineer_ids   = ["00003"]
+  adopt_existing = true
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("devops-bootcamp_dev_team.created", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devops-bootcamp_dev_team.created", "adopt_existing", "true"),
+					resource.TestCheckResourceAttr("devops-bootcamp_dev_team.created", "version", "1"),
+				),
+			},
+			// Import a seeded team, which leaves the flag unset
+			{
+				Config: providerConfig + `
+resource "devops-bootcamp_dev_team" "created" {
+  name           = "dev_new"
+  engineer_ids   = ["00003"]
+  adopt_existing = true
+}
+
+resource "devops-bootcamp_dev_team" "imported" {
+  name = "dev_ferrets"
+}
+`,
+				ResourceName:       "devops-bootcamp_dev_team.imported",
+				ImportState:        true,
+				ImportStateId:      "00004",
+				ImportStatePersist: true,
+			},
+			// Setting the flag after import only updates the flag
+			{
+				Config: providerConfig + `
+resource "devops-bootcamp_dev_team" "created" {
+  name           = "dev_new"
+  engineer_ids   = ["00003"]
+  adopt_existing = true
+}
+
+resource "devops-bootcamp_dev_team" "imported" {
+  name           = "dev_ferrets"
+  adopt_existing = true
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("devops-bootcamp_dev_team.imported", plancheck.ResourceActionUpdate),
+						plancheck.ExpectResourceAction("devops-bootcamp_dev_team.created", plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devops-bootcamp_dev_team.imported", "adopt_existing", "true"),
+					resource.TestCheckResourceAttr("devops-bootcamp_dev_team.imported", "engineer_ids.#", "1"),
+					resource.TestCheckResourceAttr("devops-bootcamp_dev_team.imported", "version", "1"),
+				),
+			},
+			// Renaming after setting the flag updates the team
+			{
+				Config: providerConfig + `
+resource "devops-bootcamp_dev_team" "created" {
+  name           = "dev_new"
+  engineer_ids   = ["00003"]
+  adopt_existing = true
+}
+
+resource "devops-bootcamp_dev_team" "imported" {
+  name           = "dev_weasels"
+  adopt_existing = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devops-bootcamp_dev_team.imported", "name", "dev_weasels"),
+					resource.TestCheckResourceAttr("devops-bootcamp_dev_team.imported", "engineer_ids.#", "1"),
+					resource.TestCheckResourceAttr("devops-bootcamp_dev_team.imported", "version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDevResource_adoptAmbiguous(t *testing.T) {
+	api := testAccFakeAPI(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					client, err := NewClient(api.URL)
+					if err != nil {
+						t.Fatalf("unable to create client: %s", err)
+					}
+					if _, err := client.CreateDev(context.Background(), "dev_bengal", nil); err != nil {
+						t.Fatalf("unable to create dev: %s", err)
+					}
+				},
+				Config: providerConfig + `
+resource "devops-bootcamp_dev_team" "test" {
+  name           = "dev_bengal"
+  adopt_existing = true
+}
+`,
+				ExpectError: regexp.MustCompile(`Multiple dev teams found`),
+			},
+		},
+	})
+}
+
+func TestAccDevResource_moved(t *testing.T) {
+	testAccFakeAPI(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with the deprecated type names
+			{
+				Config: providerConfig + `
+resource "devops-bootcamp_engineer-resource" "engineer1" {
+  name  = "John Doe"
+  email = "john.doe@example.com"
+}
+
+resource "devops-bootcamp_dev_resource" "test" {
+  name         = "Test Dev Group"
+  engineer_ids = [devops-bootcamp_engineer-resource.engineer1.id]
+}
+`,
+			},
+			// Move both to the new type names without replacing them
+			{
+				Config: providerConfig + `
+resource "devops-bootcamp_engineer" "engineer1" {
+  name  = "John Doe"
+  email = "john.doe@example.com"
+}
+
+moved {
+  from = devops-bootcamp_engineer-resource.engineer1
+  to   = devops-bootcamp_engineer.engineer1
+}
+
+resource "devops-bootcamp_dev_team" "test" {
+  name         = "Test Dev Group"
+  engineer_ids = [devops-bootcamp_engineer.engineer1.id]
+}
+
+moved {
+  from = devops-bootcamp_dev_resource.test
+  to   = devops-bootcamp_dev_team.test
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("devops-bootcamp_engineer.engineer1", plancheck.ResourceActionNoop),
+						plancheck.ExpectResourceAction("devops-bootcamp_dev_team.test", plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devops-bootcamp_dev_team.test", "engineers.#", "1"),
+					resource.TestCheckResourceAttr("devops-bootcamp_dev_team.test", "engineers.0.name", "John Doe"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "devops-bootcamp_dev_team.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "devops-bootcamp_engineer" "engineer1" {
+  name  = "John Doe"
+  email = "john.doe@example.com"
+}
+
+resource "devops-bootcamp_dev_team" "test" {
+  name = "Updated Test Dev Group"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devops-bootcamp_dev_team.test", "name", "Updated Test Dev Group"),
+					resource.TestCheckResourceAttr("devops-bootcamp_dev_team.test", "engineer_ids.#", "1"),
+				),
+			},
 		},
 	})
 }
@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func NewDevOpsDataSource() datasource.DataSource {
+	return &DevOpsDataSource{}
+}
+
+var _ datasource.DataSource = &DevOpsDataSource{}
+
+type DevOpsDataSourceModel struct {
+	DevOps []DevOpsDataSourceEntryModel `tfsdk:"devops"`
+}
+
+// DevOpsDataSourceEntryModel is a DevOps unit plus the aggregates computed
+// from its teams.
+type DevOpsDataSourceEntryModel struct {
+	Id        types.String    `tfsdk:"id"`
+	Dev       []DevModel      `tfsdk:"dev"`
+	Ops       []OpsModel      `tfsdk:"ops"`
+	Engineers []EngineerModel `tfsdk:"engineers"`
+	Headcount types.Int64     `tfsdk:"headcount"`
+}
+
+type DevOpsDataSource struct {
+	client *Client
+}
+
+func (d *DevOpsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_devops"
+}
+
+func (d *DevOpsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	engineers := schema.ListNestedAttribute{
+		Computed: true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Computed: true,
+				},
+				"id": schema.StringAttribute{
+					Computed: true,
+				},
+				"email": schema.StringAttribute{
+					Computed: true,
+				},
+			},
+		},
+	}
+	teams := schema.ListNestedAttribute{
+		Computed: true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Computed: true,
+				},
+				"id": schema.StringAttribute{
+					Computed: true,
+				},
+				"engineers": engineers,
+			},
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"devops": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"dev": teams,
+						"ops": teams,
+						"engineers": schema.ListNestedAttribute{
+							MarkdownDescription: "All engineers of the dev and ops teams, without duplicates.",
+							Computed:            true,
+							NestedObject:        engineers.NestedObject,
+						},
+						"headcount": schema.Int64Attribute{
+							MarkdownDescription: "Number of distinct engineers in the DevOps unit.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DevOpsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DevOpsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fetch DevOps from the API
+	devops, err := d.client.GetDevOps(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to fetch DevOps",
+			"An error occurred while fetching DevOps: "+err.Error(),
+		)
+		return
+	}
+
+	data.DevOps = make([]DevOpsDataSourceEntryModel, len(devops))
+	for i, unit := range devops {
+		engineers := devOpsEngineers(unit)
+		data.DevOps[i] = DevOpsDataSourceEntryModel{
+			Id:        types.StringValue(unit.Id),
+			Dev:       newDevModels(unit.Dev),
+			Ops:       newOpsModels(unit.Ops),
+			Engineers: newEngineerModels(engineers),
+			Headcount: types.Int64Value(int64(len(engineers))),
+		}
+	}
+
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *DevOpsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// devOpsEngineers returns the engineers of every dev and ops team in devops,
+// in team order and without duplicates.
+func devOpsEngineers(devops DevOps) []Engineer {
+	seen := map[string]bool{}
+	engineers := []Engineer{}
+
+	add := func(members []Engineer) {
+		for _, engineer := range members {
+			if seen[engineer.Id] {
+				continue
+			}
+			seen[engineer.Id] = true
+			engineers = append(engineers, engineer)
+		}
+	}
+
+	for _, dev := range devops.Dev {
+		add(dev.Engineers)
+	}
+	for _, ops := range devops.Ops {
+		add(ops.Engineers)
+	}
+
+	return engineers
+}
@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDevOpsDataSource(t *testing.T) {
+	testAccFakeAPI(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `data "devops-bootcamp_devops" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.devops-bootcamp_devops.test", "devops.#", "1"),
+					resource.TestCheckResourceAttr("data.devops-bootcamp_devops.test", "devops.0.dev.0.name", "dev_ferrets"),
+					resource.TestCheckResourceAttr("data.devops-bootcamp_devops.test", "devops.0.ops.0.name", "ops_ferrets"),
+					resource.TestCheckResourceAttr("data.devops-bootcamp_devops.test", "devops.0.headcount", "2"),
+					resource.TestCheckResourceAttr("data.devops-bootcamp_devops.test", "devops.0.engineers.0.email", "ryan@ferrets.com"),
+					resource.TestCheckResourceAttr("data.devops-bootcamp_devops.test", "devops.0.engineers.1.email", "bob@bob.com"),
+				),
+			},
+		},
+	})
+}
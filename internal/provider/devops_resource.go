@@ -0,0 +1,298 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+func NewDevOpsResource() resource.Resource {
+	return &DevOpsResource{}
+}
+
+var _ resource.Resource = &DevOpsResource{}
+var _ resource.ResourceWithConfigValidators = &DevOpsResource{}
+
+type DevOpsResource struct {
+	client *Client
+}
+
+type DevOpsResourceModel struct {
+	Id        types.String `tfsdk:"id"`
+	DevIds    types.Set    `tfsdk:"dev_ids"`
+	OpsIds    types.Set    `tfsdk:"ops_ids"`
+	Engineers types.Set    `tfsdk:"engineers"`
+	Headcount types.Int64  `tfsdk:"headcount"`
+}
+
+var engineerAttrTypes = map[string]attr.Type{
+	"name":  types.StringType,
+	"id":    types.StringType,
+	"email": types.StringType,
+}
+
+func (r *DevOpsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_devops_resource"
+}
+
+func (r *DevOpsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Groups dev teams and ops teams into a DevOps unit.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dev_ids": schema.SetAttribute{
+				MarkdownDescription: "Ids of the dev teams in the DevOps unit.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"ops_ids": schema.SetAttribute{
+				MarkdownDescription: "Ids of the ops teams in the DevOps unit.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"engineers": schema.SetNestedAttribute{
+				MarkdownDescription: "All engineers of the dev and ops teams, without duplicates.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"email": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+			"headcount": schema.Int64Attribute{
+				MarkdownDescription: "Number of distinct engineers in the DevOps unit.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *DevOpsResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(path.MatchRoot("dev_ids"), path.MatchRoot("ops_ids")),
+	}
+}
+
+func (r *DevOpsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DevOpsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	devIds, opsIds := data.teamIds(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create DevOps via API
+	devops, err := r.client.CreateDevOps(ctx, devIds, opsIds)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create devops",
+			"An error occurred while creating the devops: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(data.refresh(ctx, devops)...)
+
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *DevOpsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DevOpsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fetch devops from the API using GetDevOpsById
+	devops, err := r.client.GetDevOpsById(ctx, data.Id.ValueString())
+	if IsNotFound(err) {
+		tflog.Warn(ctx, "DevOps not found, removing from state", map[string]any{"id": data.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to fetch devops",
+			"An error occurred while fetching the devops: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(data.refresh(ctx, devops)...)
+
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *DevOpsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DevOpsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	devIds, opsIds := data.teamIds(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update devops via API
+	devops, err := r.client.UpdateDevOps(ctx, data.Id.ValueString(), devIds, opsIds)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to update devops",
+			"An error occurred while updating the devops: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(data.refresh(ctx, devops)...)
+
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *DevOpsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DevOpsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete devops via API
+	err := r.client.DeleteDevOps(ctx, data.Id.ValueString())
+	if err != nil && !IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to delete devops",
+			"An error occurred while deleting the devops: "+err.Error(),
+		)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *DevOpsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DevOpsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// teamIds returns the configured dev and ops team ids.
+func (m *DevOpsResourceModel) teamIds(ctx context.Context, diags *diag.Diagnostics) ([]string, []string) {
+	devIds := []string{}
+	opsIds := []string{}
+
+	if !m.DevIds.IsNull() {
+		diags.Append(m.DevIds.ElementsAs(ctx, &devIds, false)...)
+	}
+	if !m.OpsIds.IsNull() {
+		diags.Append(m.OpsIds.ElementsAs(ctx, &opsIds, false)...)
+	}
+
+	return devIds, opsIds
+}
+
+// refresh copies the API representation of a DevOps unit into the model and
+// recomputes the aggregate attributes. Team id sets that are empty in the API
+// response keep a null configuration null.
+func (m *DevOpsResourceModel) refresh(ctx context.Context, devops *DevOps) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	devIds := make([]string, len(devops.Dev))
+	for i, dev := range devops.Dev {
+		devIds[i] = dev.Id
+	}
+	opsIds := make([]string, len(devops.Ops))
+	for i, ops := range devops.Ops {
+		opsIds[i] = ops.Id
+	}
+
+	m.Id = types.StringValue(devops.Id)
+	m.DevIds = teamIdSet(ctx, m.DevIds, devIds, &diags)
+	m.OpsIds = teamIdSet(ctx, m.OpsIds, opsIds, &diags)
+
+	engineers := devOpsEngineers(*devops)
+	engineerSet, d := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: engineerAttrTypes}, newEngineerModels(engineers))
+	diags.Append(d...)
+	m.Engineers = engineerSet
+	m.Headcount = types.Int64Value(int64(len(engineers)))
+
+	return diags
+}
+
+func teamIdSet(ctx context.Context, current types.Set, ids []string, diags *diag.Diagnostics) types.Set {
+	if len(ids) == 0 && current.IsNull() {
+		return current
+	}
+
+	set, d := types.SetValueFrom(ctx, types.StringType, ids)
+	diags.Append(d...)
+
+	return set
+}
@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDevOpsResource(t *testing.T) {
+	testAccFakeAPI(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "devops-bootcamp_engineer-resource" "engineer1" {
+  name  = "John Doe"
+  email = "john.doe@example.com"
+}
+
+resource "devops-bootcamp_engineer-resource" "engineer2" {
+  name  = "Jane Smith"
+  email = "jane.smith@example.com"
+}
+
+resource "devops-bootcamp_dev_resource" "dev" {
+  name = "Test Dev Group"
+  engineer_ids = [
+    devops-bootcamp_engineer-resource.engineer1.id,
+    devops-bootcamp_engineer-resource.engineer2.id
+  ]
+}
+
+resource "devops-bootcamp_ops_resource" "ops" {
+  name = "Test Ops Group"
+  engineers = [
+    devops-bootcamp_engineer-resource.engineer2
+  ]
+}
+
+resource "devops-bootcamp_devops_resource" "test" {
+  dev_ids = [devops-bootcamp_dev_resource.dev.id]
+  ops_ids = [devops-bootcamp_ops_resource.ops.id]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("devops-bootcamp_devops_resource.test", "id"),
+					resource.TestCheckResourceAttr("devops-bootcamp_devops_resource.test", "dev_ids.#", "1"),
+					resource.TestCheckResourceAttr("devops-bootcamp_devops_resource.test", "ops_ids.#", "1"),
+					// Jane is in both teams but only counted once
+					resource.TestCheckResourceAttr("devops-bootcamp_devops_resource.test", "headcount", "2"),
+					resource.TestCheckResourceAttr("devops-bootcamp_devops_resource.test", "engineers.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("devops-bootcamp_devops_resource.test", "engineers.*", map[string]string{
+						"email": "jane.smith@example.com",
+					}),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "devops-bootcamp_devops_resource.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "devops-bootcamp_engineer-resource" "engineer1" {
+  name  = "John Doe"
+  email = "john.doe@example.com"
+}
+
+resource "devops-bootcamp_engineer-resource" "engineer2" {
+  name  = "Jane Smith"
+  email = "jane.smith@example.com"
+}
+
+resource "devops-bootcamp_dev_resource" "dev" {
+  name = "Test Dev Group"
+  engineer_ids = [
+    devops-bootcamp_engineer-resource.engineer1.id,
+    devops-bootcamp_engineer-resource.engineer2.id
+  ]
+}
+
+resource "devops-bootcamp_ops_resource" "ops" {
+  name = "Test Ops Group"
+  engineers = [
+    devops-bootcamp_engineer-resource.engineer2
+  ]
+}
+
+resource "devops-bootcamp_devops_resource" "test" {
+  ops_ids = [devops-bootcamp_ops_resource.ops.id]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("devops-bootcamp_devops_resource.test", "dev_ids"),
+					resource.TestCheckResourceAttr("devops-bootcamp_devops_resource.test", "ops_ids.#", "1"),
+					resource.TestCheckResourceAttr("devops-bootcamp_devops_resource.test", "headcount", "1"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &EmailDomainFunction{}
+
+func NewEmailDomainFunction() function.Function {
+	return &EmailDomainFunction{}
+}
+
+type EmailDomainFunction struct{}
+
+func (f *EmailDomainFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "email_domain"
+}
+
+func (f *EmailDomainFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Extract the domain of an email address",
+		MarkdownDescription: "Returns the lower-cased domain part of an email address, for example `ferrets.com` for `Ryan@Ferrets.com`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "email",
+				MarkdownDescription: "Email address to extract the domain from.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *EmailDomainFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var email string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &email))
+
+	if resp.Error != nil {
+		return
+	}
+
+	domain, ok := emailDomain(email)
+	if !ok {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid email address %q: expected exactly one @ between a local part and a domain.", email))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, domain))
+}
+
+// emailDomain returns the normalized domain of email, or false when email is
+// not of the form local@domain.
+func emailDomain(email string) (string, bool) {
+	local, domain, found := strings.Cut(normalizeEmail(email), "@")
+	if !found || local == "" || domain == "" || strings.Contains(domain, "@") {
+		return "", false
+	}
+
+	return domain, true
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestEmailDomainFunction(t *testing.T) {
+	testCases := map[string]struct {
+		email    string
+		expected function.RunResponse
+	}{
+		"valid": {
+			email: "Ryan@Ferrets.com",
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringValue("ferrets.com")),
+			},
+		},
+		"subdomain": {
+			email: "bob@eng.bob.com ",
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringValue("eng.bob.com")),
+			},
+		},
+		"missing-at": {
+			email: "ryan.ferrets.com",
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringUnknown()),
+				Error:  function.NewArgumentFuncError(0, `Invalid email address "ryan.ferrets.com": expected exactly one @ between a local part and a domain.`),
+			},
+		},
+		"missing-local-part": {
+			email: "@ferrets.com",
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringUnknown()),
+				Error:  function.NewArgumentFuncError(0, `Invalid email address "@ferrets.com": expected exactly one @ between a local part and a domain.`),
+			},
+		},
+		"multiple-at": {
+			email: "ryan@ferrets@com",
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringUnknown()),
+				Error:  function.NewArgumentFuncError(0, `Invalid email address "ryan@ferrets@com": expected exactly one @ between a local part and a domain.`),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := function.RunResponse{
+				Result: function.NewResultData(types.StringUnknown()),
+			}
+
+			NewEmailDomainFunction().Run(context.Background(), function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(testCase.email)}),
+			}, &got)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestEmailDomainFunction_config(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::devops-bootcamp::email_domain("Ryan@Ferrets.com")
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.StringExact("ferrets.com")),
+				},
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::devops-bootcamp::email_domain("ryan.ferrets.com")
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid email address`),
+			},
+		},
+	})
+}
@@ -3,9 +3,13 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 func NewEngineerDataSource() datasource.DataSource {
@@ -15,13 +19,20 @@ func NewEngineerDataSource() datasource.DataSource {
 var _ datasource.DataSource = &EngineerDataSource{}
 
 type EngineerDataSourceModel struct {
-	Engineer []EngineerModel `tfsdk:"engineer"`
+	Engineer []EngineerModel                `tfsdk:"engineer"`
+	Filter   *EngineerDataSourceFilterModel `tfsdk:"filter"`
+}
+
+type EngineerDataSourceFilterModel struct {
+	NameRegex   types.String `tfsdk:"name_regex"`
+	EmailDomain types.String `tfsdk:"email_domain"`
+	Ids         types.Set    `tfsdk:"ids"`
 }
 
 type EngineerModel struct {
-	Name  string `tfsdk:"name"`
-	Id    string `tfsdk:"id"`
-	Email string `tfsdk:"email"`
+	Name  types.String `tfsdk:"name"`
+	Id    types.String `tfsdk:"id"`
+	Email types.String `tfsdk:"email"`
 }
 
 type EngineerDataSource struct {
@@ -52,6 +63,26 @@ func (d *EngineerDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"filter": schema.SingleNestedBlock{
+				MarkdownDescription: "Only return engineers matching all of the given conditions.",
+				Attributes: map[string]schema.Attribute{
+					"name_regex": schema.StringAttribute{
+						MarkdownDescription: "Regular expression the engineer name must match.",
+						Optional:            true,
+					},
+					"email_domain": schema.StringAttribute{
+						MarkdownDescription: "Domain of the engineer email, compared case-insensitively.",
+						Optional:            true,
+					},
+					"ids": schema.SetAttribute{
+						MarkdownDescription: "Ids of the engineers to return.",
+						ElementType:         types.StringType,
+						Optional:            true,
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -65,7 +96,19 @@ func (d *EngineerDataSource) Read(ctx context.Context, req datasource.ReadReques
 	}
 
 	// Fetch engineers from the API
-	engineers, err := d.client.GetEngineers()
+	var filter EngineerFilter
+	if data.Filter != nil {
+		filter = EngineerFilter{
+			NameRegex:   compileNameRegex(data.Filter.NameRegex, &resp.Diagnostics),
+			EmailDomain: data.Filter.EmailDomain.ValueString(),
+			Ids:         filterIds(ctx, data.Filter.Ids, &resp.Diagnostics),
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	engineers, err := d.client.ListEngineers(ctx, filter)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to fetch engineers",
@@ -74,7 +117,7 @@ func (d *EngineerDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
-	data.Engineer = engineers
+	data.Engineer = newEngineerModels(engineers)
 
 	diags := resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
@@ -83,6 +126,40 @@ func (d *EngineerDataSource) Read(ctx context.Context, req datasource.ReadReques
 	}
 }
 
+// compileNameRegex compiles the name_regex attribute of a filter block. It
+// returns nil when the attribute is null.
+func compileNameRegex(value types.String, diags *diag.Diagnostics) *regexp.Regexp {
+	if value.IsNull() {
+		return nil
+	}
+
+	re, err := regexp.Compile(value.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("filter").AtName("name_regex"),
+			"Invalid name_regex",
+			"The name_regex filter is not a valid regular expression: "+err.Error(),
+		)
+		return nil
+	}
+
+	return re
+}
+
+// filterIds converts the ids attribute of a filter block. It returns nil when
+// the attribute is null and an empty slice when the set is empty, which
+// matches nothing.
+func filterIds(ctx context.Context, value types.Set, diags *diag.Diagnostics) []string {
+	if value.IsNull() {
+		return nil
+	}
+
+	ids := []string{}
+	diags.Append(value.ElementsAs(ctx, &ids, false)...)
+
+	return ids
+}
+
 // Configure adds the provider configured client to the data source.
 func (d *EngineerDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	// Add a nil check when handling ProviderData because Terraform
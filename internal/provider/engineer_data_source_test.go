@@ -1,12 +1,15 @@
 package provider
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
 func TestAccEngineerDataSource(t *testing.T) {
+	testAccFakeAPI(t)
+
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
@@ -23,3 +26,76 @@ func TestAccEngineerDataSource(t *testing.T) {
 		},
 	})
 }
+
+func TestAccEngineerDataSource_filter(t *testing.T) {
+	testAccFakeAPI(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "devops-bootcamp_engineer" "by_name" {
+  filter {
+    name_regex = "^[a-z]"
+  }
+}
+
+data "devops-bootcamp_engineer" "by_domain_and_ids" {
+  filter {
+    email_domain = "Ferrets.com"
+    ids          = ["00001", "00002"]
+  }
+}
+
+data "devops-bootcamp_engineer" "no_ids" {
+  filter {
+    ids = []
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.devops-bootcamp_engineer.by_name", "engineer.#", "2"),
+					resource.TestCheckResourceAttr("data.devops-bootcamp_engineer.by_name", "engineer.0.name", "zach"),
+					resource.TestCheckResourceAttr("data.devops-bootcamp_engineer.by_name", "engineer.1.name", "bob"),
+					resource.TestCheckResourceAttr("data.devops-bootcamp_engineer.by_domain_and_ids", "engineer.#", "1"),
+					resource.TestCheckResourceAttr("data.devops-bootcamp_engineer.by_domain_and_ids", "engineer.0.id", "00001"),
+					resource.TestCheckResourceAttr("data.devops-bootcamp_engineer.no_ids", "engineer.#", "0"),
+				),
+			},
+			{
+				Config: providerConfig + `
+data "devops-bootcamp_engineer" "test" {
+  filter {
+    name_regex = "("
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid name_regex`),
+			},
+		},
+	})
+}
+
+func TestAccEngineerDataSource_pagination(t *testing.T) {
+	testAccFakeAPI(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "devops-bootcamp" {
+  page_size = 1
+}
+
+data "devops-bootcamp_engineer" "test" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.devops-bootcamp_engineer.test", "engineer.#", "3"),
+					resource.TestCheckResourceAttr("data.devops-bootcamp_engineer.test", "engineer.2.name", "bob"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &EngineerIdsFunction{}
+
+// engineerObjectType is the smallest object type an engineer can be passed as.
+// Terraform drops any other attributes, so engineer-resource objects, data
+// source entries and plain { id = "..." } objects are all accepted.
+var engineerObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id": types.StringType,
+	},
+}
+
+type engineerIdModel struct {
+	Id types.String `tfsdk:"id"`
+}
+
+func NewEngineerIdsFunction() function.Function {
+	return &EngineerIdsFunction{}
+}
+
+type EngineerIdsFunction struct{}
+
+func (f *EngineerIdsFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "engineer_ids"
+}
+
+func (f *EngineerIdsFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Extract the ids of a list of engineers",
+		MarkdownDescription: "Returns the `id` of every engineer object in the list, in order, such as a list of `engineer-resource` resources or the `engineer` attribute of the engineer data source.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "engineers",
+				MarkdownDescription: "List of engineer objects with an `id` attribute.",
+				ElementType:         engineerObjectType,
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *EngineerIdsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var engineers []engineerIdModel
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &engineers))
+
+	if resp.Error != nil {
+		return
+	}
+
+	ids, known := engineerIds(engineers)
+	if !known {
+		resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, types.ListUnknown(types.StringType)))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, ids))
+}
+
+// engineerIds returns the ids of the given engineers, or false when any of
+// them is not known yet. Engineers without an id are skipped.
+func engineerIds(engineers []engineerIdModel) ([]string, bool) {
+	ids := make([]string, 0, len(engineers))
+	for _, engineer := range engineers {
+		if engineer.Id.IsUnknown() {
+			return nil, false
+		}
+		if engineer.Id.IsNull() {
+			continue
+		}
+		ids = append(ids, engineer.Id.ValueString())
+	}
+
+	return ids, true
+}
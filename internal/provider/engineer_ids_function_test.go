@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestEngineerIdsFunction(t *testing.T) {
+	testCases := map[string]struct {
+		engineers types.List
+		expected  function.RunResponse
+	}{
+		"engineers": {
+			engineers: testEngineerList(types.StringValue("00001"), types.StringNull(), types.StringValue("00003")),
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("00001"),
+					types.StringValue("00003"),
+				})),
+			},
+		},
+		"empty": {
+			engineers: testEngineerList(),
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.ListValueMust(types.StringType, []attr.Value{})),
+			},
+		},
+		"unknown-id": {
+			engineers: testEngineerList(types.StringValue("00001"), types.StringUnknown()),
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.ListUnknown(types.StringType)),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := function.RunResponse{
+				Result: function.NewResultData(types.ListUnknown(types.StringType)),
+			}
+
+			NewEngineerIdsFunction().Run(context.Background(), function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{testCase.engineers}),
+			}, &got)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+// testEngineerList builds a function argument holding engineers with the given ids.
+func testEngineerList(ids ...types.String) types.List {
+	engineers := make([]attr.Value, 0, len(ids))
+	for _, id := range ids {
+		engineers = append(engineers, types.ObjectValueMust(engineerObjectType.AttrTypes, map[string]attr.Value{"id": id}))
+	}
+
+	return types.ListValueMust(engineerObjectType, engineers)
+}
+
+func TestEngineerIdsFunction_config(t *testing.T) {
+	testAccFakeAPI(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Resources, data source entries and plain objects are accepted
+			{
+				Config: providerConfig + `
+resource "devops-bootcamp_engineer" "test" {
+  name  = "New Hire"
+  email = "new.hire@example.com"
+}
+
+data "devops-bootcamp_engineer" "seeded" {
+  filter {
+    ids = ["00001", "00002", "00003"]
+  }
+}
+
+output "resources" {
+  value = provider::devops-bootcamp::engineer_ids([devops-bootcamp_engineer.test])
+}
+
+output "data_source" {
+  value = provider::devops-bootcamp::engineer_ids(data.devops-bootcamp_engineer.seeded.engineer)
+}
+
+output "objects" {
+  value = provider::devops-bootcamp::engineer_ids([{ id = "00002" }])
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("resources", knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.StringExact("00009"),
+					})),
+					statecheck.ExpectKnownOutputValue("data_source", knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.StringExact("00001"),
+						knownvalue.StringExact("00002"),
+						knownvalue.StringExact("00003"),
+					})),
+					statecheck.ExpectKnownOutputValue("objects", knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.StringExact("00002"),
+					})),
+				},
+			},
+		},
+	})
+}
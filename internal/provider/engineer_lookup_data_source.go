@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func NewEngineerLookupDataSource() datasource.DataSource {
+	return &EngineerLookupDataSource{}
+}
+
+var _ datasource.DataSource = &EngineerLookupDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &EngineerLookupDataSource{}
+
+type EngineerLookupDataSourceModel struct {
+	Id    types.String `tfsdk:"id"`
+	Name  types.String `tfsdk:"name"`
+	Email types.String `tfsdk:"email"`
+}
+
+type EngineerLookupDataSource struct {
+	client *Client
+}
+
+func (d *EngineerLookupDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_engineer_lookup"
+}
+
+func (d *EngineerLookupDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up a single engineer by id, name or email.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Id of the engineer.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the engineer. Must match exactly one engineer.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Email of the engineer, compared case-insensitively. Must match exactly one engineer.",
+				Optional:            true,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *EngineerLookupDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+			path.MatchRoot("email"),
+		),
+	}
+}
+
+func (d *EngineerLookupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data EngineerLookupDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var engineer Engineer
+	if !data.Id.IsNull() {
+		// Fetch the engineer directly when the id is known
+		found, err := d.client.GetEngineerById(ctx, data.Id.ValueString())
+		if IsNotFound(err) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("id"),
+				"Engineer not found",
+				fmt.Sprintf("No engineer with id %q exists.", data.Id.ValueString()),
+			)
+			return
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to fetch engineer",
+				"An error occurred while fetching the engineer: "+err.Error(),
+			)
+			return
+		}
+		engineer = *found
+	} else {
+		// Email lookups go through the API's email domain filter, only name
+		// lookups have to scan every engineer
+		attribute, value := "name", data.Name.ValueString()
+		var matches []Engineer
+		var err error
+		if !data.Email.IsNull() {
+			attribute, value = "email", data.Email.ValueString()
+			matches, err = d.client.FindEngineersByEmail(ctx, value)
+		} else {
+			var engineers []Engineer
+			engineers, err = d.client.GetEngineers(ctx)
+			matches = filterSlice(engineers, func(e Engineer) bool {
+				return e.Name == value
+			})
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to fetch engineers",
+				"An error occurred while fetching engineers: "+err.Error(),
+			)
+			return
+		}
+
+		switch len(matches) {
+		case 0:
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attribute),
+				"Engineer not found",
+				fmt.Sprintf("No engineer with %s %q exists.", attribute, value),
+			)
+			return
+		case 1:
+			engineer = matches[0]
+		default:
+			ids := make([]string, 0, len(matches))
+			for _, e := range matches {
+				ids = append(ids, e.Id)
+			}
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attribute),
+				"Multiple engineers found",
+				fmt.Sprintf("%d engineers have %s %q (ids: %s). Look the engineer up by id instead.",
+					len(matches), attribute, value, strings.Join(ids, ", ")),
+			)
+			return
+		}
+	}
+
+	data.Id = types.StringValue(engineer.Id)
+	data.Name = types.StringValue(engineer.Name)
+	data.Email = types.StringPointerValue(engineer.Email)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *EngineerLookupDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
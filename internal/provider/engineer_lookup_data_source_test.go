@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccEngineerLookupDataSource(t *testing.T) {
+	testAccFakeAPI(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+data "devops-bootcamp_engineer_lookup" "by_id" {
+  id = "00001"
+}
+
+data "devops-bootcamp_engineer_lookup" "by_name" {
+  name = "Ryan"
+}
+
+data "devops-bootcamp_engineer_lookup" "by_email" {
+  email = "RYAN@ferrets.com"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.devops-bootcamp_engineer_lookup.by_id", "name", "Ryan"),
+					resource.TestCheckResourceAttr("data.devops-bootcamp_engineer_lookup.by_id", "email", "ryan@ferrets.com"),
+					resource.TestCheckResourceAttr("data.devops-bootcamp_engineer_lookup.by_name", "id", "00001"),
+					resource.TestCheckResourceAttr("data.devops-bootcamp_engineer_lookup.by_email", "id", "00001"),
+					resource.TestCheckResourceAttr("data.devops-bootcamp_engineer_lookup.by_email", "email", "ryan@ferrets.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEngineerLookupDataSource_emailFilter(t *testing.T) {
+	api := testAccFakeAPI(t)
+
+	// Email lookups only list the engineers of the email's domain
+	checkFiltered := func(*terraform.State) error {
+		api.mu.Lock()
+		defer api.mu.Unlock()
+		if len(api.engineerQueries) == 0 {
+			return fmt.Errorf("expected engineers to be listed")
+		}
+		for _, query := range api.engineerQueries {
+			if domain := query.Get("email_domain"); domain != "ferrets.com" {
+				return fmt.Errorf("expected engineers to be listed with email_domain ferrets.com, got: %q", domain)
+			}
+		}
+		return nil
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "devops-bootcamp_engineer_lookup" "by_email" {
+  email = "RYAN@ferrets.com"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.devops-bootcamp_engineer_lookup.by_email", "id", "00001"),
+					checkFiltered,
+				),
+			},
+		},
+	})
+}
+
+func TestAccEngineerLookupDataSource_errors(t *testing.T) {
+	testAccFakeAPI(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "devops-bootcamp_engineer_lookup" "test" {
+  id   = "00001"
+  name = "Ryan"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: providerConfig + `
+data "devops-bootcamp_engineer_lookup" "test" {
+  id = "99999"
+}
+`,
+				ExpectError: regexp.MustCompile(`No engineer with id "99999" exists`),
+			},
+			{
+				Config: providerConfig + `
+data "devops-bootcamp_engineer_lookup" "test" {
+  email = "nobody@ferrets.com"
+}
+`,
+				ExpectError: regexp.MustCompile(`No engineer with email "nobody@ferrets.com" exists`),
+			},
+			{
+				Config: providerConfig + `
+resource "devops-bootcamp_engineer-resource" "twin" {
+  name  = "Ryan"
+  email = "ryan.twin@ferrets.com"
+}
+
+data "devops-bootcamp_engineer_lookup" "test" {
+  name = devops-bootcamp_engineer-resource.twin.name
+}
+`,
+				ExpectError: regexp.MustCompile(`2 engineers have name "Ryan"`),
+			},
+		},
+	})
+}
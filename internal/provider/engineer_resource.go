@@ -3,51 +3,64 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 func NewEngineerResource() resource.Resource {
 	return &EngineerResource{}
 }
 
+// NewLegacyEngineerResource returns the resource under its deprecated
+// _engineer-resource type name.
+func NewLegacyEngineerResource() resource.Resource {
+	return &EngineerResource{legacy: true}
+}
+
 var _ resource.Resource = &EngineerResource{}
+var _ resource.ResourceWithUpgradeState = &EngineerResource{}
+var _ resource.ResourceWithMoveState = &EngineerResource{}
+var _ resource.ResourceWithModifyPlan = &EngineerResource{}
 
 type EngineerResource struct {
 	client *Client
+
+	// legacy registers the resource under its deprecated type name.
+	legacy bool
 }
 
 type EngineerResourceModel struct {
-	Name  types.String `tfsdk:"name"`
-	Id    types.String `tfsdk:"id"`
-	Email types.String `tfsdk:"email"`
+	Name      types.String `tfsdk:"name"`
+	Id        types.String `tfsdk:"id"`
+	Email     types.String `tfsdk:"email"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+	Version   types.Int64  `tfsdk:"version"`
+
+	AdoptExisting types.Bool `tfsdk:"adopt_existing"`
 }
 
 func (r *EngineerResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_engineer-resource"
+	if r.legacy {
+		resp.TypeName = req.ProviderTypeName + "_engineer-resource"
+		return
+	}
+	resp.TypeName = req.ProviderTypeName + "_engineer"
 }
 
 func (r *EngineerResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		Attributes: map[string]schema.Attribute{
-			"name": schema.StringAttribute{
-				Required: true,
-			},
-			"id": schema.StringAttribute{
-				Computed: true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
-				},
-			},
-			"email": schema.StringAttribute{
-				Optional: true,
-			},
-		},
+	resp.Schema = engineerResourceSchema()
+	if r.legacy {
+		resp.Schema.DeprecationMessage = "Use devops-bootcamp_engineer instead, moving existing resources with a moved block. " +
+			"The devops-bootcamp_engineer-resource type will be removed in the next major version."
 	}
 }
 
@@ -60,17 +73,34 @@ func (r *EngineerResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	// Create engineer via API
-	engineer, err := r.client.CreateEngineer(data.Name.ValueString(), data.Email.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to create engineer",
-			"An error occurred while creating the engineer: "+err.Error(),
-		)
-		return
+	// Only set when configured, so imported and created resources match
+	if data.AdoptExisting.IsUnknown() {
+		data.AdoptExisting = types.BoolNull()
+	}
+
+	var engineer *Engineer
+	if data.AdoptExisting.ValueBool() {
+		engineer = r.adoptExisting(ctx, data, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Create engineer via API, unless an existing one was adopted
+	if engineer == nil {
+		var err error
+		engineer, err = r.client.CreateEngineer(ctx, data.Name.ValueString(), data.Email.ValueStringPointer())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to create engineer",
+				"An error occurred while creating the engineer: "+err.Error(),
+			)
+			return
+		}
 	}
 
-	data.Id = types.StringValue(engineer.Id)
+	data.refresh(engineer)
+	setETag(ctx, resp.Private, engineer.ETag, &resp.Diagnostics)
 
 	diags := resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
@@ -89,7 +119,12 @@ func (r *EngineerResource) Read(ctx context.Context, req resource.ReadRequest, r
 	}
 
 	// Fetch engineer from the API using GetEngineerById
-	engineer, err := r.client.GetEngineerById(data.Id.ValueString())
+	engineer, err := r.client.GetEngineerById(ctx, data.Id.ValueString())
+	if IsNotFound(err) {
+		tflog.Warn(ctx, "Engineer not found, removing from state", map[string]any{"id": data.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to fetch engineer",
@@ -98,8 +133,8 @@ func (r *EngineerResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	data.Name = types.StringValue(engineer.Name)
-	data.Email = types.StringValue(engineer.Email)
+	data.refresh(engineer)
+	setETag(ctx, resp.Private, engineer.ETag, &resp.Diagnostics)
 
 	diags := resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
@@ -117,8 +152,25 @@ func (r *EngineerResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	// Update engineer via API
-	engineer, err := r.client.UpdateEngineer(data.Id.ValueString(), data.Name.ValueString(), data.Email.ValueString())
+	var state EngineerResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only adopt_existing changed, which the API doesn't know about
+	if data.Name.Equal(state.Name) && data.Email.Equal(state.Email) {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	// Update engineer via API, unless it changed since it was last read
+	etag := getETag(ctx, req.Private, &resp.Diagnostics)
+	engineer, err := r.client.UpdateEngineer(ctx, data.Id.ValueString(), data.Name.ValueString(), data.Email.ValueStringPointer(), WithIfMatch(etag))
+	if IsPreconditionFailed(err) {
+		addChangedOutsideError(&resp.Diagnostics, "engineer", data.Id.ValueString(), "updated")
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to update engineer",
@@ -127,8 +179,8 @@ func (r *EngineerResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	data.Name = types.StringValue(engineer.Name)
-	data.Email = types.StringValue(engineer.Email)
+	data.refresh(engineer)
+	setETag(ctx, resp.Private, engineer.ETag, &resp.Diagnostics)
 
 	diags := resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
@@ -146,9 +198,14 @@ func (r *EngineerResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
-	// Delete engineer via API
-	err := r.client.DeleteEngineer(data.Id.ValueString())
-	if err != nil {
+	// Delete engineer via API, unless it changed since it was last read
+	etag := getETag(ctx, req.Private, &resp.Diagnostics)
+	err := r.client.DeleteEngineer(ctx, data.Id.ValueString(), WithIfMatch(etag))
+	if IsPreconditionFailed(err) {
+		addChangedOutsideError(&resp.Diagnostics, "engineer", data.Id.ValueString(), "deleted")
+		return
+	}
+	if err != nil && !IsNotFound(err) {
 		resp.Diagnostics.AddError(
 			"Unable to delete engineer",
 			"An error occurred while deleting the engineer: "+err.Error(),
@@ -159,6 +216,35 @@ func (r *EngineerResource) Delete(ctx context.Context, req resource.DeleteReques
 	resp.State.RemoveResource(ctx)
 }
 
+// ModifyPlan keeps the prior adopt_existing, which only matters on create,
+// unless it is configured. When nothing else changed it keeps the rest of the
+// prior state, so changing the flag only plans an update of the flag itself.
+func (r *EngineerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to keep on create and destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state EngineerResourceModel
+	var configAdoptExisting types.Bool
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("adopt_existing"), &configAdoptExisting)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	adoptExisting := state.AdoptExisting
+	if !configAdoptExisting.IsNull() {
+		adoptExisting = configAdoptExisting
+	}
+
+	if plan.Name.Equal(state.Name) && plan.Email.Equal(state.Email) {
+		resp.Plan.Raw = req.State.Raw
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("adopt_existing"), adoptExisting)...)
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *EngineerResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Add a nil check when handling ProviderData because Terraform
@@ -184,3 +270,201 @@ func (r *EngineerResource) ImportState(ctx context.Context, req resource.ImportS
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// UpgradeState migrates states of earlier engineer schema versions.
+func (r *EngineerResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(engineerResourceSchema(), engineerResourceMigrations())
+}
+
+// MoveState accepts moved blocks from the deprecated engineer-resource type.
+func (r *EngineerResource) MoveState(_ context.Context) []resource.StateMover {
+	if r.legacy {
+		return nil
+	}
+	return []resource.StateMover{
+		stateMover("devops-bootcamp_engineer-resource", engineerResourceSchema(), engineerResourceMigrations()),
+	}
+}
+
+// adoptExisting takes over the engineer with the planned email and updates it
+// to the planned values. It returns nil when no engineer has the email.
+func (r *EngineerResource) adoptExisting(ctx context.Context, data EngineerResourceModel, diags *diag.Diagnostics) *Engineer {
+	if data.Email.IsNull() {
+		return nil
+	}
+
+	matches, err := r.client.FindEngineersByEmail(ctx, data.Email.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Unable to fetch engineers",
+			"An error occurred while fetching engineers: "+err.Error(),
+		)
+		return nil
+	}
+	switch {
+	case len(matches) == 0:
+		return nil
+	case len(matches) > 1:
+		ids := make([]string, len(matches))
+		for i, engineer := range matches {
+			ids[i] = engineer.Id
+		}
+		diags.AddAttributeError(
+			path.Root("email"),
+			"Multiple engineers found",
+			fmt.Sprintf("%d engineers have email %q (ids: %s), so none of them can be adopted.", len(matches), data.Email.ValueString(), strings.Join(ids, ", ")),
+		)
+		return nil
+	}
+
+	// Read the engineer for its entity tag, so the update doesn't overwrite
+	// a change made since it was found
+	existing, err := r.client.GetEngineerById(ctx, matches[0].Id)
+	if err != nil {
+		diags.AddError(
+			"Unable to fetch engineer",
+			"An error occurred while fetching the engineer: "+err.Error(),
+		)
+		return nil
+	}
+
+	engineer, err := r.client.UpdateEngineer(ctx, existing.Id, data.Name.ValueString(), data.Email.ValueStringPointer(), WithIfMatch(existing.ETag))
+	if IsPreconditionFailed(err) {
+		addChangedOutsideError(diags, "engineer", existing.Id, "adopted")
+		return nil
+	}
+	if err != nil {
+		diags.AddError(
+			"Unable to adopt engineer",
+			"An error occurred while updating the existing engineer: "+err.Error(),
+		)
+		return nil
+	}
+
+	diags.AddWarning(
+		"Adopted existing engineer",
+		fmt.Sprintf("The engineer %s (name %q, email %q) already existed, so it was taken over instead of creating a new one and updated to the configuration. "+
+			"Terraform now manages it and deletes it on destroy.", existing.Id, existing.Name, existing.emailAddress()),
+	)
+
+	return engineer
+}
+
+// refresh copies the API representation of an engineer into the model.
+// Create, Read, Update and Import all go through it, so they store the same
+// state for the same engineer. The API may trim names and lowercase emails, so
+// a planned or stored value equal to the normalized one is kept, otherwise
+// Terraform would see a different value than it planned.
+func (m *EngineerResourceModel) refresh(engineer *Engineer) {
+	m.Id = types.StringValue(engineer.Id)
+	m.Name = keepEquivalent(m.Name, &engineer.Name, strings.TrimSpace)
+	m.Email = keepEquivalent(m.Email, engineer.Email, normalizeEmail)
+	m.CreatedAt = types.StringPointerValue(engineer.CreatedAt)
+	m.UpdatedAt = types.StringPointerValue(engineer.UpdatedAt)
+	m.Version = types.Int64PointerValue(engineer.Version)
+}
+
+// keepEquivalent returns current if it normalizes to the same string as value,
+// and value otherwise.
+func keepEquivalent(current types.String, value *string, normalize func(string) string) types.String {
+	if value != nil && !current.IsNull() && !current.IsUnknown() && normalize(current.ValueString()) == normalize(*value) {
+		return current
+	}
+
+	return types.StringPointerValue(value)
+}
+
+func engineerResourceSchema() schema.Schema {
+	return schema.Schema{
+		Version: 2,
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"email": schema.StringAttribute{
+				Optional: true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Time the engineer was created, set by the API.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Time the engineer was last changed, set by the API.",
+				Computed:            true,
+			},
+			"version": schema.Int64Attribute{
+				MarkdownDescription: "Revision of the engineer, incremented by the API on every change.",
+				Computed:            true,
+			},
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over an existing engineer with the same email, compared case-insensitively, instead of creating a new one. " +
+					"Only applies on create, changing it later has no effect. Engineers without an email are always created.",
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// engineerResourceMigrations upgrades engineer states of version i to i+1.
+func engineerResourceMigrations() []stateMigration {
+	return []stateMigration{
+		{priorSchema: engineerResourceSchemaV0(), migrate: migrateEngineerStateV0},
+		{priorSchema: engineerResourceSchemaV1(), migrate: migrateEngineerStateV1},
+	}
+}
+
+// engineerResourceSchemaV0 had the same attributes as version 1, but omitted
+// emails were stored as empty strings.
+func engineerResourceSchemaV0() schema.Schema {
+	v0 := engineerResourceSchemaV1()
+	v0.Version = 0
+	return v0
+}
+
+// engineerResourceSchemaV1 had no server-managed attributes.
+func engineerResourceSchemaV1() schema.Schema {
+	return schema.Schema{
+		Version: 1,
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"email": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+// migrateEngineerStateV0 turns the empty email of engineers created without
+// one back into null, matching their configuration.
+func migrateEngineerStateV0(_ context.Context, state map[string]any) error {
+	if email, ok := state["email"].(string); ok && email == "" {
+		state["email"] = nil
+	}
+
+	return nil
+}
+
+// migrateEngineerStateV1 adds the server-managed attributes as null. The next
+// refresh fills them in.
+func migrateEngineerStateV1(_ context.Context, state map[string]any) error {
+	state["created_at"] = nil
+	state["updated_at"] = nil
+	state["version"] = nil
+
+	return nil
+}
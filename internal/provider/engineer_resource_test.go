@@ -1,12 +1,21 @@
 package provider
 
 import (
+	"context"
+	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 func TestAccEngineerResource(t *testing.T) {
+	testAccFakeAPI(t)
+
+	var createdAt string
+
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
@@ -23,6 +32,12 @@ resource "devops-bootcamp_engineer-resource" "test" {
 					resource.TestCheckResourceAttr("devops-bootcamp_engineer-resource.test", "name", "John Doe"),
 					resource.TestCheckResourceAttr("devops-bootcamp_engineer-resource.test", "email", "john.doe@example.com"),
 					resource.TestCheckResourceAttrSet("devops-bootcamp_engineer-resource.test", "id"),
+					resource.TestCheckResourceAttrWith("devops-bootcamp_engineer-resource.test", "created_at", func(value string) error {
+						createdAt = value
+						return nil
+					}),
+					resource.TestCheckResourceAttrPair("devops-bootcamp_engineer-resource.test", "updated_at", "devops-bootcamp_engineer-resource.test", "created_at"),
+					resource.TestCheckResourceAttr("devops-bootcamp_engineer-resource.test", "version", "1"),
 				),
 			},
 			// ImportState testing
@@ -43,9 +58,487 @@ resource "devops-bootcamp_engineer-resource" "test" {
 					// Verify updated attributes
 					resource.TestCheckResourceAttr("devops-bootcamp_engineer-resource.test", "name", "Jane Doe"),
 					resource.TestCheckResourceAttr("devops-bootcamp_engineer-resource.test", "email", "jane.doe@example.com"),
+					// Verify server-managed attributes from the update response
+					resource.TestCheckResourceAttrWith("devops-bootcamp_engineer-resource.test", "created_at", func(value string) error {
+						if value != createdAt {
+							return fmt.Errorf("expected created_at %q to be kept, got: %q", createdAt, value)
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttrWith("devops-bootcamp_engineer-resource.test", "updated_at", func(value string) error {
+						if value <= createdAt {
+							return fmt.Errorf("expected updated_at after %q, got: %q", createdAt, value)
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttr("devops-bootcamp_engineer-resource.test", "version", "2"),
 				),
 			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
 }
+
+func TestAccEngineerResource_noEmail(t *testing.T) {
+	api := testAccFakeAPI(t)
+
+	// The API must not receive an email for engineers configured without one
+	checkNoEmailSent := func(*terraform.State) error {
+		api.mu.Lock()
+		defer api.mu.Unlock()
+		for _, engineer := range api.engineers {
+			if engineer.Name == "No Mail" && engineer.Email != nil {
+				return fmt.Errorf("expected no email to be sent, got: %q", *engineer.Email)
+			}
+		}
+		return nil
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "devops-bootcamp_engineer" "test" {
+  name = "No Mail"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("devops-bootcamp_engineer.test", "email"),
+					checkNoEmailSent,
+				),
+			},
+			{
+				ResourceName:      "devops-bootcamp_engineer.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: providerConfig + `
+resource "devops-bootcamp_engineer" "test" {
+  name  = "No Mail"
+  email = "no.mail@example.com"
+}
+`,
+				Check: resource.TestCheckResourceAttr("devops-bootcamp_engineer.test", "email", "no.mail@example.com"),
+			},
+			// Removing the email sets it back to null
+			{
+				Config: providerConfig + `
+resource "devops-bootcamp_engineer" "test" {
+  name = "No Mail"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("devops-bootcamp_engineer.test", "email"),
+					checkNoEmailSent,
+				),
+			},
+		},
+	})
+}
+
+func TestAccEngineerResource_normalized(t *testing.T) {
+	api := testAccFakeAPI(t)
+	api.normalizeInput = true
+
+	config := func(name string) string {
+		return providerConfig + fmt.Sprintf(`
+resource "devops-bootcamp_engineer" "test" {
+  name  = %q
+  email = "MIXED@Example.com"
+}
+`, name)
+	}
+
+	// The API stores the normalized values while the state keeps the configured ones
+	checkStored := func(name, email string) resource.TestCheckFunc {
+		return func(*terraform.State) error {
+			api.mu.Lock()
+			defer api.mu.Unlock()
+			for _, engineer := range api.engineers {
+				if engineer.Email != nil && *engineer.Email == email {
+					if engineer.Name != name {
+						return fmt.Errorf("expected stored name %q, got: %q", name, engineer.Name)
+					}
+					return nil
+				}
+			}
+			return fmt.Errorf("expected an engineer with email %q to be stored", email)
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(" Mixed Case "),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devops-bootcamp_engineer.test", "name", " Mixed Case "),
+					resource.TestCheckResourceAttr("devops-bootcamp_engineer.test", "email", "MIXED@Example.com"),
+					checkStored("Mixed Case", "mixed@example.com"),
+				),
+			},
+			{
+				Config: config("Mixed Case Renamed"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devops-bootcamp_engineer.test", "name", "Mixed Case Renamed"),
+					resource.TestCheckResourceAttr("devops-bootcamp_engineer.test", "email", "MIXED@Example.com"),
+					resource.TestCheckResourceAttr("devops-bootcamp_engineer.test", "version", "2"),
+					checkStored("Mixed Case Renamed", "mixed@example.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEngineerResource_disappears(t *testing.T) {
+	api := testAccFakeAPI(t)
+
+	var engineerId string
+
+	config := providerConfig + `
+resource "devops-bootcamp_engineer-resource" "test" {
+  name  = "Gone Soon"
+  email = "gone.soon@example.com"
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.TestCheckResourceAttrWith("devops-bootcamp_engineer-resource.test", "id", func(value string) error {
+					engineerId = value
+					return nil
+				}),
+			},
+			// Deleting the engineer outside Terraform should plan a recreate
+			{
+				PreConfig: func() {
+					client, err := NewClient(api.URL)
+					if err != nil {
+						t.Fatalf("unable to create client: %s", err)
+					}
+					if err := client.DeleteEngineer(context.Background(), engineerId); err != nil {
+						t.Fatalf("unable to delete engineer %s: %s", engineerId, err)
+					}
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEngineerResource_changedOutside(t *testing.T) {
+	api := testAccFakeAPI(t)
+
+	var engineerId string
+
+	config := func(name string) string {
+		return providerConfig + fmt.Sprintf(`
+resource "devops-bootcamp_engineer" "test" {
+  name  = %q
+  email = "racy@example.com"
+}
+`, name)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("Racy"),
+				Check: resource.TestCheckResourceAttrWith("devops-bootcamp_engineer.test", "id", func(value string) error {
+					engineerId = value
+					return nil
+				}),
+			},
+			// Another writer changes the engineer between plan and apply
+			{
+				Config: config("Racy Renamed"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						planCheckFunc(func() {
+							client, err := NewClient(api.URL)
+							if err != nil {
+								t.Fatalf("unable to create client: %s", err)
+							}
+							if _, err := client.UpdateEngineer(context.Background(), engineerId, "Racy", stringPointer("racy@elsewhere.com")); err != nil {
+								t.Fatalf("unable to update engineer: %s", err)
+							}
+						}),
+					},
+				},
+				ExpectError: regexp.MustCompile(`Resource changed outside this run`),
+			},
+			// After a refresh the change applies on top of the other writer's
+			{
+				Config: config("Racy Renamed"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devops-bootcamp_engineer.test", "name", "Racy Renamed"),
+					resource.TestCheckResourceAttr("devops-bootcamp_engineer.test", "email", "racy@example.com"),
+					resource.TestCheckResourceAttr("devops-bootcamp_engineer.test", "version", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEngineerResource_adoptExisting(t *testing.T) {
+	testAccFakeAPI(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The seeded engineer with the email is adopted, the other is created
+			{
+				Config: providerConfig + `
+resource "devops-bootcamp_engineer" "adopted" {
+  name           = "Zach"
+  email          = "Zach@Bengal.com"
+  adopt_existing = true
+}
+
+resource "devops-bootcamp_engineer" "created" {
+  name           = "New Hire"
+  email          = "new.hire@example.com"
+  adopt_existing = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devops-bootcamp_engineer.adopted", "id", "00002"),
+					resource.TestCheckResourceAttr("devops-bootcamp_engineer.adopted", "name", "Zach"),
+					resource.TestCheckResourceAttr("devops-bootcamp_engineer.adopted", "email", "Zach@Bengal.com"),
+					resource.TestCheckResourceAttr("devops-bootcamp_engineer.adopted", "version", "2"),
+					resource.TestCheckResourceAttr("devops-bootcamp_engineer.created", "id", "00009"),
+					resource.TestCheckResourceAttr("devops-bootcamp_engineer.created", "version", "1"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "devops-bootcamp_engineer.adopted",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"adopt_existing"},
+			},
+			// Changing the flag after create only updates the flag, removing it
+			// keeps the prior value
+			{
+				Config: providerConfig + `
+resource "devops-bootcamp_engineer" "adopted" {
+  name           = "Zach"
+  email          = "Zach@Bengal.com"
+  adopt_existing = false
+}
+
+resource "devops-bootcamp_engineer" "created" {
+  name  = "New Hire"
+  email = "new.hire@example.com"
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("devops-bootcamp_engineer.adopted", plancheck.ResourceActionUpdate),
+						plancheck.ExpectResourceAction("devops-bootcamp_engineer.created", plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devops-bootcamp_engineer.adopted", "adopt_existing", "false"),
+					resource.TestCheckResourceAttr("devops-bootcamp_engineer.created", "adopt_existing", "true"),
+					resource.TestCheckResourceAttr("devops-bootcamp_engineer.adopted", "version", "2"),
+					resource.TestCheckResourceAttr("devops-bootcamp_engineer.created", "version", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEngineerResource_adoptExistingLater(t *testing.T) {
+	testAccFakeAPI(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create without the flag
+			{
+				Config: providerConfig + `
+resource "devops-bootcamp_engineer" "created" {
+  name  = "New Hire"
+  email = "new.hire@example.com"
+}
+`,
+				Check: resource.TestCheckNoResourceAttr("devops-bootcamp_engineer.created", "adopt_existing"),
+			},
+			// Setting the flag later only updates the flag
+			{
+				Config: providerConfig + `
+resource "devops-bootcamp_engineer" "created" {
+  name           = "New Hire"
+  email          = "new.hire@example.com"
+  adopt_existing = true
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("devops-bootcamp_engineer.created", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devops-bootcamp_engineer.created", "adopt_existing", "true"),
+					resource.TestCheckResourceAttr("devops-bootcamp_engineer.created", "version", "1"),
+				),
+			},
+			// Import a seeded engineer, which leaves the flag unset
+			{
+				Config: providerConfig + `
+resource "devops-bootcamp_engineer" "created" {
+  name           = "New Hire"
+  email          = "new.hire@example.com"
+  adopt_existing = true
+}
+
+resource "devops-bootcamp_engineer" "imported" {
+  name  = "zach"
+  email = "zach@bengal.com"
+}
+`,
+				ResourceName:       "devops-bootcamp_engineer.imported",
+				ImportState:        true,
+				ImportStateId:      "00002",
+				ImportStatePersist: true,
+			},
+			// Setting the flag after import only updates the flag
+			{
+				Config: providerConfig + `
+resource "devops-bootcamp_engineer" "created" {
+  name           = "New Hire"
+  email          = "new.hire@example.com"
+  adopt_existing = true
+}
+
+resource "devops-bootcamp_engineer" "imported" {
+  name           = "zach"
+  email          = "zach@bengal.com"
+  adopt_existing = true
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("devops-bootcamp_engineer.imported", plancheck.ResourceActionUpdate),
+						plancheck.ExpectResourceAction("devops-bootcamp_engineer.created", plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devops-bootcamp_engineer.imported", "adopt_existing", "true"),
+					resource.TestCheckResourceAttr("devops-bootcamp_engineer.imported", "version", "1"),
+				),
+			},
+			// Renaming after setting the flag updates the engineer
+			{
+				Config: providerConfig + `
+resource "devops-bootcamp_engineer" "created" {
+  name           = "New Hire"
+  email          = "new.hire@example.com"
+  adopt_existing = true
+}
+
+resource "devops-bootcamp_engineer" "imported" {
+  name           = "Zach"
+  email          = "zach@bengal.com"
+  adopt_existing = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devops-bootcamp_engineer.imported", "name", "Zach"),
+					resource.TestCheckResourceAttr("devops-bootcamp_engineer.imported", "adopt_existing", "true"),
+					resource.TestCheckResourceAttr("devops-bootcamp_engineer.imported", "version", "2"),
+				),
+			},
+		},
+	})
+}
+
+// planCheckFunc runs a function as a plan check, for example to change API
+// objects between plan and apply.
+type planCheckFunc func()
+
+func (f planCheckFunc) CheckPlan(_ context.Context, _ plancheck.CheckPlanRequest, _ *plancheck.CheckPlanResponse) {
+	f()
+}
+
+func TestAccEngineerResource_moved(t *testing.T) {
+	testAccFakeAPI(t)
+
+	var engineerId string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with the deprecated type name
+			{
+				Config: providerConfig + `
+resource "devops-bootcamp_engineer-resource" "test" {
+  name  = "John Doe"
+  email = "john.doe@example.com"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("devops-bootcamp_engineer-resource.test", "id", func(value string) error {
+						engineerId = value
+						return nil
+					}),
+				),
+			},
+			// Move to the new type name without replacing the engineer
+			{
+				Config: providerConfig + `
+resource "devops-bootcamp_engineer" "test" {
+  name  = "John Doe"
+  email = "john.doe@example.com"
+}
+
+moved {
+  from = devops-bootcamp_engineer-resource.test
+  to   = devops-bootcamp_engineer.test
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("devops-bootcamp_engineer.test", plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("devops-bootcamp_engineer.test", "id", func(value string) error {
+						if value != engineerId {
+							return fmt.Errorf("expected id %q to be kept, got: %q", engineerId, value)
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttr("devops-bootcamp_engineer.test", "name", "John Doe"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "devops-bootcamp_engineer.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "devops-bootcamp_engineer" "test" {
+  name  = "Jane Doe"
+  email = "jane.doe@example.com"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devops-bootcamp_engineer.test", "name", "Jane Doe"),
+				),
+			},
+		},
+	})
+}
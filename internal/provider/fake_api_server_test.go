@@ -0,0 +1,615 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeEngineer and fakeTeam mirror the JSON documents served by the bootcamp
+// API. They are deliberately independent of the client models so the fake
+// keeps describing the wire format even when the client changes.
+type fakeEngineer struct {
+	Id    string  `json:"id"`
+	Name  string  `json:"name"`
+	Email *string `json:"email,omitempty"`
+
+	CreatedAt string `json:"created_at,omitempty"`
+	UpdatedAt string `json:"updated_at,omitempty"`
+	Version   int64  `json:"version,omitempty"`
+}
+
+// fakeTeam is the document of a dev or ops team.
+type fakeTeam struct {
+	Id        string         `json:"id"`
+	Name      string         `json:"name"`
+	Engineers []fakeEngineer `json:"engineers"`
+
+	CreatedAt string `json:"created_at,omitempty"`
+	UpdatedAt string `json:"updated_at,omitempty"`
+	Version   int64  `json:"version,omitempty"`
+}
+
+// fakeDevOps is the document of a DevOps unit. The fake only stores the team
+// ids and resolves them on every response, like the API does.
+type fakeDevOps struct {
+	Id  string     `json:"id"`
+	Dev []fakeTeam `json:"dev"`
+	Ops []fakeTeam `json:"ops"`
+}
+
+// fakeAPIServer is an in-memory implementation of the bootcamp API used by the
+// acceptance tests.
+type fakeAPIServer struct {
+	*httptest.Server
+
+	mu        sync.Mutex
+	nextId    int
+	clock     time.Time
+	seeding   bool
+	engineers []*fakeEngineer
+	devs      *fakeTeamStore
+	ops       *fakeTeamStore
+	devops    []*fakeDevOps
+
+	// engineerQueries records the query of every engineer list request.
+	engineerQueries []url.Values
+
+	// normalizeInput makes the server trim engineer names and lowercase
+	// emails on write, like the real API may.
+	normalizeInput bool
+
+	// replays holds the responses to POST requests by idempotency key.
+	replaysMu sync.Mutex
+	replays   map[string]*httptest.ResponseRecorder
+}
+
+// testAccFakeAPI starts a fake bootcamp API seeded with the fixture data and
+// points the provider at it through BOOTCAMP_API_ENDPOINT. Every test gets its
+// own server, which is shut down when the test finishes.
+func testAccFakeAPI(t *testing.T) *fakeAPIServer {
+	t.Helper()
+
+	api := newFakeAPIServer()
+	api.seed()
+	t.Cleanup(api.Close)
+
+	t.Setenv("BOOTCAMP_API_ENDPOINT", api.URL)
+
+	return api
+}
+
+func newFakeAPIServer() *fakeAPIServer {
+	// Seeded objects are an hour old, so they predate every request
+	api := &fakeAPIServer{clock: time.Now().UTC().Add(-time.Hour).Truncate(time.Second)}
+	api.devs = &fakeTeamStore{api: api, kind: "dev"}
+	api.ops = &fakeTeamStore{api: api, kind: "ops"}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /engineers", api.listEngineers)
+	mux.HandleFunc("GET /engineers/id/{id}", api.getEngineer)
+	mux.HandleFunc("POST /engineers", api.createEngineer)
+	mux.HandleFunc("PUT /engineers/{id}", api.updateEngineer)
+	mux.HandleFunc("DELETE /engineers/{id}", api.deleteEngineer)
+	for path, store := range map[string]*fakeTeamStore{"/dev": api.devs, "/ops": api.ops} {
+		mux.HandleFunc("GET "+path, store.list)
+		mux.HandleFunc("GET "+path+"/id/{id}", store.get)
+		mux.HandleFunc("POST "+path, store.create)
+		mux.HandleFunc("PUT "+path+"/{id}", store.update)
+		mux.HandleFunc("DELETE "+path+"/{id}", store.delete)
+	}
+	mux.HandleFunc("GET /devops", api.listDevOps)
+	mux.HandleFunc("GET /devops/id/{id}", api.getDevOps)
+	mux.HandleFunc("POST /devops", api.createDevOps)
+	mux.HandleFunc("PUT /devops/{id}", api.updateDevOps)
+	mux.HandleFunc("DELETE /devops/{id}", api.deleteDevOps)
+
+	api.Server = httptest.NewServer(api.replayIdempotent(mux))
+
+	return api
+}
+
+// replayIdempotent answers POST requests repeating an earlier Idempotency-Key
+// with the response to the first request, like the real API.
+func (api *fakeAPIServer) replayIdempotent(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get("Idempotency-Key")
+		if r.Method != http.MethodPost || key == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		api.replaysMu.Lock()
+		defer api.replaysMu.Unlock()
+
+		key = r.URL.Path + " " + key
+		recorder, ok := api.replays[key]
+		if !ok {
+			recorder = httptest.NewRecorder()
+			next.ServeHTTP(recorder, r)
+			if api.replays == nil {
+				api.replays = map[string]*httptest.ResponseRecorder{}
+			}
+			api.replays[key] = recorder
+		}
+
+		for name, values := range recorder.Header() {
+			w.Header()[name] = values
+		}
+		w.WriteHeader(recorder.Code)
+		_, _ = w.Write(recorder.Body.Bytes())
+	})
+}
+
+// seed loads the same fixtures as the live test environment: three engineers
+// and two dev teams, the first of which contains Ryan, plus two ops teams, the
+// first of which contains bob, and a DevOps unit grouping both ferrets teams.
+func (api *fakeAPIServer) seed() {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	api.seeding = true
+	defer func() { api.seeding = false }()
+
+	ryan := api.addEngineer(fakeEngineer{Name: "Ryan", Email: stringPointer("ryan@ferrets.com")})
+	api.addEngineer(fakeEngineer{Name: "zach", Email: stringPointer("zach@bengal.com")})
+	bob := api.addEngineer(fakeEngineer{Name: "bob", Email: stringPointer("bob@bob.com")})
+
+	devFerrets := api.devs.add(fakeTeam{Name: "dev_ferrets", Engineers: []fakeEngineer{*ryan}})
+	api.devs.add(fakeTeam{Name: "dev_bengal"})
+
+	opsFerrets := api.ops.add(fakeTeam{Name: "ops_ferrets", Engineers: []fakeEngineer{*bob}})
+	api.ops.add(fakeTeam{Name: "ops_bengal"})
+
+	api.devops = append(api.devops, &fakeDevOps{
+		Id:  api.newId(),
+		Dev: []fakeTeam{{Id: devFerrets.Id}},
+		Ops: []fakeTeam{{Id: opsFerrets.Id}},
+	})
+}
+
+func (api *fakeAPIServer) newId() string {
+	api.nextId++
+	return fmt.Sprintf("%05d", api.nextId)
+}
+
+// tick advances the fake clock by a second and returns the new time, so every
+// change gets a distinct timestamp. Outside of seeding the clock also catches
+// up with the current time, so changes aren't dated before their request.
+func (api *fakeAPIServer) tick() string {
+	api.clock = api.clock.Add(time.Second)
+	if now := time.Now().UTC().Truncate(time.Second); !api.seeding && api.clock.Before(now) {
+		api.clock = now
+	}
+	return api.clock.Format(time.RFC3339)
+}
+
+func (api *fakeAPIServer) addEngineer(engineer fakeEngineer) *fakeEngineer {
+	engineer.Id = api.newId()
+	engineer.CreatedAt = api.tick()
+	engineer.UpdatedAt = engineer.CreatedAt
+	engineer.Version = 1
+	api.engineers = append(api.engineers, &engineer)
+	return &engineer
+}
+
+func (api *fakeAPIServer) normalizeEngineer(engineer *fakeEngineer) {
+	if !api.normalizeInput {
+		return
+	}
+	engineer.Name = strings.TrimSpace(engineer.Name)
+	if engineer.Email != nil {
+		engineer.Email = stringPointer(normalizeEmail(*engineer.Email))
+	}
+}
+
+func (api *fakeAPIServer) findEngineer(id string) (int, *fakeEngineer) {
+	for i, engineer := range api.engineers {
+		if engineer.Id == id {
+			return i, engineer
+		}
+	}
+	return -1, nil
+}
+
+func (api *fakeAPIServer) listEngineers(w http.ResponseWriter, r *http.Request) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	// The fake only filters by id and ignores other parameters, so tests also
+	// cover the filters the client applies itself.
+	api.engineerQueries = append(api.engineerQueries, r.URL.Query())
+
+	ids := r.URL.Query()["id"]
+	engineers := []*fakeEngineer{}
+	for _, engineer := range api.engineers {
+		if len(ids) == 0 || slices.Contains(ids, engineer.Id) {
+			engineers = append(engineers, engineer)
+		}
+	}
+
+	writeJSON(w, http.StatusOK, paginate(w, r, engineers))
+}
+
+func (api *fakeAPIServer) getEngineer(w http.ResponseWriter, r *http.Request) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	_, engineer := api.findEngineer(r.PathValue("id"))
+	if engineer == nil {
+		writeError(w, http.StatusNotFound, "engineer not found")
+		return
+	}
+
+	writeETag(w, engineer.Version)
+	writeJSON(w, http.StatusOK, engineer)
+}
+
+func (api *fakeAPIServer) createEngineer(w http.ResponseWriter, r *http.Request) {
+	var engineer fakeEngineer
+	if err := json.NewDecoder(r.Body).Decode(&engineer); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	api.normalizeEngineer(&engineer)
+	created := api.addEngineer(engineer)
+	writeETag(w, created.Version)
+	writeJSON(w, http.StatusCreated, created)
+}
+
+func (api *fakeAPIServer) updateEngineer(w http.ResponseWriter, r *http.Request) {
+	var update fakeEngineer
+	if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	_, engineer := api.findEngineer(r.PathValue("id"))
+	if engineer == nil {
+		writeError(w, http.StatusNotFound, "engineer not found")
+		return
+	}
+	if !checkIfMatch(w, r, engineer.Version) {
+		return
+	}
+
+	api.normalizeEngineer(&update)
+	engineer.Name = update.Name
+	engineer.Email = update.Email
+	engineer.UpdatedAt = api.tick()
+	engineer.Version++
+
+	writeETag(w, engineer.Version)
+	writeJSON(w, http.StatusOK, engineer)
+}
+
+func (api *fakeAPIServer) deleteEngineer(w http.ResponseWriter, r *http.Request) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	i, engineer := api.findEngineer(r.PathValue("id"))
+	if engineer == nil {
+		writeError(w, http.StatusNotFound, "engineer not found")
+		return
+	}
+	if !checkIfMatch(w, r, engineer.Version) {
+		return
+	}
+
+	api.engineers = append(api.engineers[:i], api.engineers[i+1:]...)
+
+	writeJSON(w, http.StatusOK, map[string]string{"message": "engineer deleted"})
+}
+
+// fakeTeamStore serves one team collection, such as /dev or /ops. All
+// collections share the server lock.
+type fakeTeamStore struct {
+	api   *fakeAPIServer
+	kind  string
+	teams []*fakeTeam
+}
+
+func (store *fakeTeamStore) add(team fakeTeam) *fakeTeam {
+	team.Id = store.api.newId()
+	team.CreatedAt = store.api.tick()
+	team.UpdatedAt = team.CreatedAt
+	team.Version = 1
+	if team.Engineers == nil {
+		team.Engineers = []fakeEngineer{}
+	}
+	store.teams = append(store.teams, &team)
+	return &team
+}
+
+func (store *fakeTeamStore) find(id string) (int, *fakeTeam) {
+	for i, team := range store.teams {
+		if team.Id == id {
+			return i, team
+		}
+	}
+	return -1, nil
+}
+
+func (store *fakeTeamStore) list(w http.ResponseWriter, r *http.Request) {
+	store.api.mu.Lock()
+	defer store.api.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, paginate(w, r, store.teams))
+}
+
+func (store *fakeTeamStore) get(w http.ResponseWriter, r *http.Request) {
+	store.api.mu.Lock()
+	defer store.api.mu.Unlock()
+
+	_, team := store.find(r.PathValue("id"))
+	if team == nil {
+		writeError(w, http.StatusNotFound, store.kind+" not found")
+		return
+	}
+
+	writeETag(w, team.Version)
+	writeJSON(w, http.StatusOK, team)
+}
+
+func (store *fakeTeamStore) create(w http.ResponseWriter, r *http.Request) {
+	var team fakeTeam
+	if err := json.NewDecoder(r.Body).Decode(&team); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	store.api.mu.Lock()
+	defer store.api.mu.Unlock()
+
+	created := store.add(team)
+	writeETag(w, created.Version)
+	writeJSON(w, http.StatusCreated, created)
+}
+
+func (store *fakeTeamStore) update(w http.ResponseWriter, r *http.Request) {
+	var update fakeTeam
+	if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	store.api.mu.Lock()
+	defer store.api.mu.Unlock()
+
+	_, team := store.find(r.PathValue("id"))
+	if team == nil {
+		writeError(w, http.StatusNotFound, store.kind+" not found")
+		return
+	}
+	if !checkIfMatch(w, r, team.Version) {
+		return
+	}
+
+	team.Name = update.Name
+	team.Engineers = update.Engineers
+	if team.Engineers == nil {
+		team.Engineers = []fakeEngineer{}
+	}
+	team.UpdatedAt = store.api.tick()
+	team.Version++
+
+	writeETag(w, team.Version)
+	writeJSON(w, http.StatusOK, team)
+}
+
+func (store *fakeTeamStore) delete(w http.ResponseWriter, r *http.Request) {
+	store.api.mu.Lock()
+	defer store.api.mu.Unlock()
+
+	i, team := store.find(r.PathValue("id"))
+	if team == nil {
+		writeError(w, http.StatusNotFound, store.kind+" not found")
+		return
+	}
+	if !checkIfMatch(w, r, team.Version) {
+		return
+	}
+
+	store.teams = append(store.teams[:i], store.teams[i+1:]...)
+
+	writeJSON(w, http.StatusOK, map[string]string{"message": store.kind + " deleted"})
+}
+
+func (api *fakeAPIServer) findDevOps(id string) (int, *fakeDevOps) {
+	for i, devops := range api.devops {
+		if devops.Id == id {
+			return i, devops
+		}
+	}
+	return -1, nil
+}
+
+// resolveDevOps returns devops with the current state of its teams.
+func (api *fakeAPIServer) resolveDevOps(devops *fakeDevOps) fakeDevOps {
+	resolved := fakeDevOps{Id: devops.Id, Dev: []fakeTeam{}, Ops: []fakeTeam{}}
+	for _, ref := range devops.Dev {
+		if _, team := api.devs.find(ref.Id); team != nil {
+			resolved.Dev = append(resolved.Dev, *team)
+		}
+	}
+	for _, ref := range devops.Ops {
+		if _, team := api.ops.find(ref.Id); team != nil {
+			resolved.Ops = append(resolved.Ops, *team)
+		}
+	}
+	return resolved
+}
+
+// decodeDevOps reads a DevOps request body and checks that all its teams exist.
+func (api *fakeAPIServer) decodeDevOps(r *http.Request) (*fakeDevOps, string) {
+	var devops fakeDevOps
+	if err := json.NewDecoder(r.Body).Decode(&devops); err != nil {
+		return nil, err.Error()
+	}
+
+	for _, ref := range devops.Dev {
+		if _, team := api.devs.find(ref.Id); team == nil {
+			return nil, fmt.Sprintf("dev %q not found", ref.Id)
+		}
+	}
+	for _, ref := range devops.Ops {
+		if _, team := api.ops.find(ref.Id); team == nil {
+			return nil, fmt.Sprintf("ops %q not found", ref.Id)
+		}
+	}
+
+	return &devops, ""
+}
+
+func (api *fakeAPIServer) listDevOps(w http.ResponseWriter, r *http.Request) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	resolved := []fakeDevOps{}
+	for _, devops := range api.devops {
+		resolved = append(resolved, api.resolveDevOps(devops))
+	}
+
+	writeJSON(w, http.StatusOK, paginate(w, r, resolved))
+}
+
+func (api *fakeAPIServer) getDevOps(w http.ResponseWriter, r *http.Request) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	_, devops := api.findDevOps(r.PathValue("id"))
+	if devops == nil {
+		writeError(w, http.StatusNotFound, "devops not found")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, api.resolveDevOps(devops))
+}
+
+func (api *fakeAPIServer) createDevOps(w http.ResponseWriter, r *http.Request) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	devops, message := api.decodeDevOps(r)
+	if devops == nil {
+		writeError(w, http.StatusBadRequest, message)
+		return
+	}
+
+	devops.Id = api.newId()
+	api.devops = append(api.devops, devops)
+
+	writeJSON(w, http.StatusCreated, api.resolveDevOps(devops))
+}
+
+func (api *fakeAPIServer) updateDevOps(w http.ResponseWriter, r *http.Request) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	_, devops := api.findDevOps(r.PathValue("id"))
+	if devops == nil {
+		writeError(w, http.StatusNotFound, "devops not found")
+		return
+	}
+
+	update, message := api.decodeDevOps(r)
+	if update == nil {
+		writeError(w, http.StatusBadRequest, message)
+		return
+	}
+
+	devops.Dev = update.Dev
+	devops.Ops = update.Ops
+
+	writeJSON(w, http.StatusOK, api.resolveDevOps(devops))
+}
+
+func (api *fakeAPIServer) deleteDevOps(w http.ResponseWriter, r *http.Request) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	i, devops := api.findDevOps(r.PathValue("id"))
+	if devops == nil {
+		writeError(w, http.StatusNotFound, "devops not found")
+		return
+	}
+
+	api.devops = append(api.devops[:i], api.devops[i+1:]...)
+
+	writeJSON(w, http.StatusOK, map[string]string{"message": "devops deleted"})
+}
+
+// paginate returns the page of items selected by the limit and cursor query
+// parameters and links to the next page, if any. Without a limit every item is
+// returned.
+func paginate[T any](w http.ResponseWriter, r *http.Request, items []T) []T {
+	query := r.URL.Query()
+	limit, err := strconv.Atoi(query.Get("limit"))
+	if err != nil || limit <= 0 {
+		return items
+	}
+
+	// The cursor is the offset of the page; clients must treat it as opaque
+	start, _ := strconv.Atoi(query.Get("cursor"))
+	start = min(max(start, 0), len(items))
+	end := min(start+limit, len(items))
+
+	if end < len(items) {
+		query.Set("cursor", strconv.Itoa(end))
+		w.Header().Set("Link", fmt.Sprintf(`<%s?%s>; rel="next"`, r.URL.Path, query.Encode()))
+	}
+
+	return items[start:end]
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, statusCode int, message string) {
+	writeJSON(w, statusCode, map[string]string{"message": message})
+}
+
+// fakeETag derives the entity tag of a document from its version.
+func fakeETag(version int64) string {
+	return fmt.Sprintf(`"%d"`, version)
+}
+
+func writeETag(w http.ResponseWriter, version int64) {
+	w.Header().Set("ETag", fakeETag(version))
+}
+
+// checkIfMatch rejects a conditional request with 412 Precondition Failed when
+// the document no longer has the entity tag the client read.
+func checkIfMatch(w http.ResponseWriter, r *http.Request, version int64) bool {
+	ifMatch := r.Header.Get("If-Match")
+	if ifMatch == "" || ifMatch == "*" || ifMatch == fakeETag(version) {
+		return true
+	}
+
+	writeError(w, http.StatusPreconditionFailed, "precondition failed")
+	return false
+}
+
+// stringPointer returns a pointer to s, for the optional fields of the API
+// types.
+func stringPointer(s string) *string {
+	return &s
+}
@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The functions below convert between the API documents in api-types.go and
+// the Terraform models of the data sources and resources.
+
+func newEngineerModel(engineer Engineer) EngineerModel {
+	return EngineerModel{
+		Name:  types.StringValue(engineer.Name),
+		Id:    types.StringValue(engineer.Id),
+		Email: types.StringPointerValue(engineer.Email),
+	}
+}
+
+func newEngineerModels(engineers []Engineer) []EngineerModel {
+	models := make([]EngineerModel, len(engineers))
+	for i, engineer := range engineers {
+		models[i] = newEngineerModel(engineer)
+	}
+	return models
+}
+
+func (m EngineerModel) toAPI() Engineer {
+	return Engineer{
+		Name:  m.Name.ValueString(),
+		Id:    m.Id.ValueString(),
+		Email: m.Email.ValueStringPointer(),
+	}
+}
+
+func engineerModelsToAPI(models []EngineerModel) []Engineer {
+	engineers := make([]Engineer, len(models))
+	for i, model := range models {
+		engineers[i] = model.toAPI()
+	}
+	return engineers
+}
+
+func newDevModel(dev Dev) DevModel {
+	return DevModel{
+		Name:      types.StringValue(dev.Name),
+		Id:        types.StringValue(dev.Id),
+		Engineers: newEngineerModels(dev.Engineers),
+	}
+}
+
+func newDevModels(devs []Dev) []DevModel {
+	models := make([]DevModel, len(devs))
+	for i, dev := range devs {
+		models[i] = newDevModel(dev)
+	}
+	return models
+}
+
+func newOpsModel(ops Ops) OpsModel {
+	return OpsModel{
+		Name:      types.StringValue(ops.Name),
+		Id:        types.StringValue(ops.Id),
+		Engineers: newEngineerModels(ops.Engineers),
+	}
+}
+
+func newOpsModels(ops []Ops) []OpsModel {
+	models := make([]OpsModel, len(ops))
+	for i, team := range ops {
+		models[i] = newOpsModel(team)
+	}
+	return models
+}
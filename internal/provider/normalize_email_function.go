@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &NormalizeEmailFunction{}
+
+func NewNormalizeEmailFunction() function.Function {
+	return &NormalizeEmailFunction{}
+}
+
+type NormalizeEmailFunction struct{}
+
+func (f *NormalizeEmailFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "normalize_email"
+}
+
+func (f *NormalizeEmailFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Normalize an email address",
+		MarkdownDescription: "Trims surrounding whitespace and lower-cases an email address so it can be compared with the addresses stored by the DevOps API.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "email",
+				MarkdownDescription: "Email address to normalize.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *NormalizeEmailFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var email string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &email))
+
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, normalizeEmail(email)))
+}
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
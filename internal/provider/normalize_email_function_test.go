@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestNormalizeEmailFunction(t *testing.T) {
+	testCases := map[string]struct {
+		email    string
+		expected function.RunResponse
+	}{
+		"mixed-case": {
+			email: "Ryan@Ferrets.COM",
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringValue("ryan@ferrets.com")),
+			},
+		},
+		"whitespace": {
+			email: "  zach@bengal.com\n",
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringValue("zach@bengal.com")),
+			},
+		},
+		"empty": {
+			email: "",
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringValue("")),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := function.RunResponse{
+				Result: function.NewResultData(types.StringUnknown()),
+			}
+
+			NewNormalizeEmailFunction().Run(context.Background(), function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(testCase.email)}),
+			}, &got)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestNormalizeEmailFunction_config(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::devops-bootcamp::normalize_email(" Ryan@Ferrets.COM ")
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.StringExact("ryan@ferrets.com")),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,27 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOpsDataSource(t *testing.T) {
+	testAccFakeAPI(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `data "devops-bootcamp_ops" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.devops-bootcamp_ops.test", "ops.#", "2"),
+					resource.TestCheckResourceAttr("data.devops-bootcamp_ops.test", "ops.0.name", "ops_ferrets"),
+					resource.TestCheckResourceAttr("data.devops-bootcamp_ops.test", "ops.0.engineers.#", "1"),
+					resource.TestCheckResourceAttr("data.devops-bootcamp_ops.test", "ops.0.engineers.0.email", "bob@bob.com"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,220 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+func NewOpsResource() resource.Resource {
+	return &OpsResource{}
+}
+
+var _ resource.Resource = &OpsResource{}
+
+type OpsResource struct {
+	client *Client
+}
+
+type OpsResourceModel struct {
+	Name      types.String    `tfsdk:"name"`
+	Id        types.String    `tfsdk:"id"`
+	Engineers []EngineerModel `tfsdk:"engineers"`
+}
+
+func (r *OpsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ops_resource"
+}
+
+func (r *OpsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"engineers": schema.ListNestedAttribute{
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Optional: true,
+						},
+						"id": schema.StringAttribute{
+							Optional: true,
+						},
+						"email": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *OpsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data OpsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create Ops via API
+	ops, err := r.client.CreateOps(ctx, data.Name.ValueString(), engineerModelsToAPI(data.Engineers))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create ops",
+			"An error occurred while creating the ops: "+err.Error(),
+		)
+		return
+	}
+
+	data.Id = types.StringValue(ops.Id)
+	data.Name = types.StringValue(ops.Name)
+	data.Engineers = opsEngineerModels(data.Engineers, ops.Engineers)
+
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *OpsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data OpsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fetch ops from the API using GetOpsById
+	ops, err := r.client.GetOpsById(ctx, data.Id.ValueString())
+	if IsNotFound(err) {
+		tflog.Warn(ctx, "Ops not found, removing from state", map[string]any{"id": data.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to fetch ops",
+			"An error occurred while fetching the ops: "+err.Error(),
+		)
+		return
+	}
+
+	data.Id = types.StringValue(ops.Id)
+	data.Name = types.StringValue(ops.Name)
+	data.Engineers = opsEngineerModels(data.Engineers, ops.Engineers)
+
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *OpsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data OpsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update ops via API
+	ops, err := r.client.UpdateOps(ctx, data.Id.ValueString(), data.Name.ValueString(), engineerModelsToAPI(data.Engineers))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to update ops",
+			"An error occurred while updating the ops: "+err.Error(),
+		)
+		return
+	}
+
+	data.Id = types.StringValue(ops.Id)
+	data.Name = types.StringValue(ops.Name)
+	data.Engineers = opsEngineerModels(data.Engineers, ops.Engineers)
+
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *OpsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data OpsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete ops via API
+	err := r.client.DeleteOps(ctx, data.Id.ValueString())
+	if err != nil && !IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to delete ops",
+			"An error occurred while deleting the ops: "+err.Error(),
+		)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// opsEngineerModels converts the engineers of an ops team for the state. The
+// engineers attribute is optional, so a null list stays null while the team
+// has no engineers instead of becoming an empty list.
+func opsEngineerModels(current []EngineerModel, engineers []Engineer) []EngineerModel {
+	if current == nil && len(engineers) == 0 {
+		return nil
+	}
+
+	return newEngineerModels(engineers)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *OpsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OpsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOpsResource(t *testing.T) {
+	testAccFakeAPI(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "devops-bootcamp_engineer-resource" "engineer1" {
+  name  = "John Doe"
+  email = "john.doe@example.com"
+}
+
+resource "devops-bootcamp_engineer-resource" "engineer2" {
+  name  = "Jane Smith"
+  email = "jane.smith@example.com"
+}
+
+resource "devops-bootcamp_ops_resource" "test" {
+  name = "Test Ops Group"
+  engineers = [
+    devops-bootcamp_engineer-resource.engineer1,
+    devops-bootcamp_engineer-resource.engineer2
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify attributes for engineer1
+					resource.TestCheckResourceAttr("devops-bootcamp_engineer-resource.engineer1", "name", "John Doe"),
+					resource.TestCheckResourceAttr("devops-bootcamp_engineer-resource.engineer1", "email", "john.doe@example.com"),
+					resource.TestCheckResourceAttrSet("devops-bootcamp_engineer-resource.engineer1", "id"),
+					// Verify attributes for engineer2
+					resource.TestCheckResourceAttr("devops-bootcamp_engineer-resource.engineer2", "name", "Jane Smith"),
+					resource.TestCheckResourceAttr("devops-bootcamp_engineer-resource.engineer2", "email", "jane.smith@example.com"),
+					resource.TestCheckResourceAttrSet("devops-bootcamp_engineer-resource.engineer2", "id"),
+					// Verify attributes for ops resource
+					resource.TestCheckResourceAttrSet("devops-bootcamp_ops_resource.test", "id"),
+					resource.TestCheckResourceAttr("devops-bootcamp_ops_resource.test", "name", "Test Ops Group"),
+					resource.TestCheckResourceAttr("devops-bootcamp_ops_resource.test", "engineers.#", "2"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "devops-bootcamp_ops_resource.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "devops-bootcamp_engineer-resource" "engineer1" {
+  name  = "Updated John Doe"
+  email = "john.doe@example.com"
+}
+
+resource "devops-bootcamp_engineer-resource" "engineer2" {
+  name  = "Updated Jane Smith"
+  email = "jane.smith@example.com"
+}
+
+resource "devops-bootcamp_ops_resource" "test" {
+  name = "Updated Test Ops Group"
+  engineers = [
+    devops-bootcamp_engineer-resource.engineer1,
+    devops-bootcamp_engineer-resource.engineer2
+  ]
+}
+`,
+			},
+		},
+	})
+}
+
+func TestAccOpsResource_noEngineers(t *testing.T) {
+	testAccFakeAPI(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "devops-bootcamp_ops_resource" "test" {
+  name = "Empty Ops Group"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devops-bootcamp_ops_resource.test", "name", "Empty Ops Group"),
+					resource.TestCheckNoResourceAttr("devops-bootcamp_ops_resource.test", "engineers"),
+				),
+			},
+			{
+				ResourceName:      "devops-bootcamp_ops_resource.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// An explicitly empty list is kept
+			{
+				Config: providerConfig + `
+resource "devops-bootcamp_ops_resource" "test" {
+  name      = "Empty Ops Group"
+  engineers = []
+}
+`,
+				Check: resource.TestCheckResourceAttr("devops-bootcamp_ops_resource.test", "engineers.#", "0"),
+			},
+		},
+	})
+}
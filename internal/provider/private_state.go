@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// privateETagKey is the private state key holding the entity tag of the
+// last API response for the resource.
+const privateETagKey = "etag"
+
+// privateStateReader and privateStateWriter are implemented by the Private
+// field of the resource requests and responses.
+type privateStateReader interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+type privateStateWriter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// getETag returns the entity tag stored in the private state, or "" when the
+// resource has none, for example because the API sends no ETag headers.
+func getETag(ctx context.Context, private privateStateReader, diags *diag.Diagnostics) string {
+	value, d := private.GetKey(ctx, privateETagKey)
+	diags.Append(d...)
+	if len(value) == 0 {
+		return ""
+	}
+
+	var etag string
+	if err := json.Unmarshal(value, &etag); err != nil {
+		diags.AddError(
+			"Unable to read private state",
+			"An error occurred while reading the stored entity tag: "+err.Error(),
+		)
+	}
+
+	return etag
+}
+
+// setETag stores etag in the private state, removing the stored one when etag
+// is empty.
+func setETag(ctx context.Context, private privateStateWriter, etag string, diags *diag.Diagnostics) {
+	var value []byte
+	if etag != "" {
+		value, _ = json.Marshal(etag)
+	}
+
+	diags.Append(private.SetKey(ctx, privateETagKey, value)...)
+}
+
+// addChangedOutsideError reports a conditional request that failed because
+// the resource was changed after Terraform last read it.
+func addChangedOutsideError(diags *diag.Diagnostics, kind, id, action string) {
+	diags.AddError(
+		"Resource changed outside this run",
+		fmt.Sprintf("The %s %s was modified since Terraform last read it, so it was not %s to avoid overwriting those changes. "+
+			"Run terraform plan again to review the current state before applying.", kind, id, action),
+	)
+}
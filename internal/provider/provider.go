@@ -5,9 +5,17 @@ package provider
 
 import (
 	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
 	"os"
+	"strconv"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/providervalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -18,6 +26,8 @@ import (
 
 // Ensure DevOpsAPIProvider satisfies various provider interfaces.
 var _ provider.Provider = &DevOpsAPIProvider{}
+var _ provider.ProviderWithConfigValidators = &DevOpsAPIProvider{}
+var _ provider.ProviderWithFunctions = &DevOpsAPIProvider{}
 
 // DevOpsAPIProvider defines the provider implementation.
 type DevOpsAPIProvider struct {
@@ -29,7 +39,24 @@ type DevOpsAPIProvider struct {
 
 // DevOpsAPIProviderModel describes the provider data model.
 type DevOpsAPIProviderModel struct {
-	Endpoint types.String `tfsdk:"endpoint"`
+	Endpoint     types.String `tfsdk:"endpoint"`
+	MaxRetries   types.Int64  `tfsdk:"max_retries"`
+	RetryMinWait types.String `tfsdk:"retry_min_wait"`
+	RetryMaxWait types.String `tfsdk:"retry_max_wait"`
+	Token        types.String `tfsdk:"token"`
+	Username     types.String `tfsdk:"username"`
+	Password     types.String `tfsdk:"password"`
+	Headers      types.Map    `tfsdk:"headers"`
+
+	CACertFile         types.String `tfsdk:"ca_cert_file"`
+	CACertPEM          types.String `tfsdk:"ca_cert_pem"`
+	ClientCert         types.String `tfsdk:"client_cert"`
+	ClientKey          types.String `tfsdk:"client_key"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+
+	RequestTimeout types.String `tfsdk:"request_timeout"`
+	PageSize       types.Int64  `tfsdk:"page_size"`
+	StrictDecoding types.Bool   `tfsdk:"strict_decoding"`
 }
 
 func (p *DevOpsAPIProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -44,10 +71,100 @@ func (p *DevOpsAPIProvider) Schema(ctx context.Context, req provider.SchemaReque
 				MarkdownDescription: "Example provider attribute",
 				Optional:            true,
 			},
+			"request_timeout": schema.StringAttribute{
+				MarkdownDescription: "Timeout of a single API request attempt, as a Go duration such as `10s`. " +
+					"Defaults to `10s`, `0s` disables the timeout. May also be set with the `BOOTCAMP_REQUEST_TIMEOUT` environment variable.",
+				Optional: true,
+			},
+			"page_size": schema.Int64Attribute{
+				MarkdownDescription: "Number of items requested per page when listing engineers and teams. " +
+					"By default the API chooses the page size. May also be set with the `BOOTCAMP_PAGE_SIZE` environment variable.",
+				Optional: true,
+			},
+			"strict_decoding": schema.BoolAttribute{
+				MarkdownDescription: "Log a warning for API responses with unknown or missing fields. Useful when debugging API changes. " +
+					"May also be set with the `BOOTCAMP_STRICT_DECODING` environment variable.",
+				Optional: true,
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of retries for rate limited (429) and transient (502, 503, 504) API responses. " +
+					"Defaults to `3`, `0` disables retries. May also be set with the `BOOTCAMP_MAX_RETRIES` environment variable.",
+				Optional: true,
+			},
+			"retry_min_wait": schema.StringAttribute{
+				MarkdownDescription: "Minimum time to wait between retries, as a Go duration such as `500ms` or `1s`. " +
+					"Defaults to `1s`. May also be set with the `BOOTCAMP_RETRY_MIN_WAIT` environment variable.",
+				Optional: true,
+			},
+			"retry_max_wait": schema.StringAttribute{
+				MarkdownDescription: "Maximum time to wait between retries, as a Go duration such as `30s`. " +
+					"Defaults to `30s`. May also be set with the `BOOTCAMP_RETRY_MAX_WAIT` environment variable.",
+				Optional: true,
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "API token sent as a bearer token in the `Authorization` header. " +
+					"Conflicts with `username` and `password`. May also be set with the `BOOTCAMP_API_TOKEN` environment variable.",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "Username for HTTP basic authentication. Requires `password`. " +
+					"May also be set with the `BOOTCAMP_API_USERNAME` environment variable.",
+				Optional: true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "Password for HTTP basic authentication. Requires `username`. " +
+					"May also be set with the `BOOTCAMP_API_PASSWORD` environment variable.",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"headers": schema.MapAttribute{
+				MarkdownDescription: "Additional HTTP headers sent with every API request. " +
+					"An `Authorization` header cannot be combined with `token` or basic authentication.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a PEM-encoded CA bundle used to verify the API server certificate, in addition to the system roots. " +
+					"Conflicts with `ca_cert_pem`. May also be set with the `BOOTCAMP_CA_CERT_FILE` environment variable.",
+				Optional: true,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM-encoded CA bundle used to verify the API server certificate, in addition to the system roots. " +
+					"Conflicts with `ca_cert_file`. May also be set with the `BOOTCAMP_CA_CERT_PEM` environment variable.",
+				Optional: true,
+			},
+			"client_cert": schema.StringAttribute{
+				MarkdownDescription: "PEM-encoded client certificate for mutual TLS. Requires `client_key`. " +
+					"May also be set with the `BOOTCAMP_CLIENT_CERT` environment variable.",
+				Optional: true,
+			},
+			"client_key": schema.StringAttribute{
+				MarkdownDescription: "PEM-encoded private key of `client_cert`. Requires `client_cert`. " +
+					"May also be set with the `BOOTCAMP_CLIENT_KEY` environment variable.",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Skip verification of the API server certificate. Only use this for local testing. " +
+					"May also be set with the `BOOTCAMP_INSECURE_SKIP_VERIFY` environment variable.",
+				Optional: true,
+			},
 		},
 	}
 }
 
+func (p *DevOpsAPIProvider) ConfigValidators(_ context.Context) []provider.ConfigValidator {
+	return []provider.ConfigValidator{
+		providervalidator.Conflicting(path.MatchRoot("token"), path.MatchRoot("username")),
+		providervalidator.Conflicting(path.MatchRoot("token"), path.MatchRoot("password")),
+		providervalidator.RequiredTogether(path.MatchRoot("username"), path.MatchRoot("password")),
+		providervalidator.Conflicting(path.MatchRoot("ca_cert_file"), path.MatchRoot("ca_cert_pem")),
+		providervalidator.RequiredTogether(path.MatchRoot("client_cert"), path.MatchRoot("client_key")),
+	}
+}
+
 func (p *DevOpsAPIProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	tflog.Info(ctx, "Configuring the provider")
 	var data DevOpsAPIProviderModel
@@ -63,6 +180,17 @@ func (p *DevOpsAPIProvider) Configure(ctx context.Context, req provider.Configur
 		)
 	}
 
+	for attr, value := range map[string]types.String{"token": data.Token, "username": data.Username, "password": data.Password} {
+		if value.IsUnknown() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attr),
+				"Unknown DevOps API Credentials",
+				fmt.Sprintf("The provider cannot create the DevOps API client as there is an unknown configuration value for %s. "+
+					"Set the value statically in the configuration or use the environment variable instead.", attr),
+			)
+		}
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -88,7 +216,33 @@ func (p *DevOpsAPIProvider) Configure(ctx context.Context, req provider.Configur
 	ctx = tflog.SetField(ctx, "devops_api_endpoint", endpoint)
 	tflog.Debug(ctx, "Creating DevOps API client")
 
-	client, err := NewClient(&endpoint)
+	opts := []ClientOption{
+		WithUserAgent(fmt.Sprintf("%s/%s", defaultUserAgent, p.version)),
+		retryOption(data, &resp.Diagnostics),
+	}
+	opts = append(opts, authOptions(ctx, data, &resp.Diagnostics)...)
+
+	if tlsConfig := clientTLSConfig(data, &resp.Diagnostics); tlsConfig != nil {
+		opts = append(opts, WithTLSConfig(tlsConfig))
+	}
+
+	if timeout, ok := durationAttribute(data.RequestTimeout, "BOOTCAMP_REQUEST_TIMEOUT", path.Root("request_timeout"), &resp.Diagnostics); ok {
+		opts = append(opts, WithTimeout(timeout))
+	}
+
+	if pageSize, ok := pageSizeAttribute(data.PageSize, &resp.Diagnostics); ok {
+		opts = append(opts, WithPageSize(pageSize))
+	}
+
+	if strictDecodingAttribute(data.StrictDecoding, &resp.Diagnostics) {
+		opts = append(opts, WithStrictDecoding(true))
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := NewClient(endpoint, opts...)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create DevOps API Client",
@@ -104,10 +258,306 @@ func (p *DevOpsAPIProvider) Configure(ctx context.Context, req provider.Configur
 
 }
 
+// retryOption builds the retry settings from the provider configuration,
+// falling back to the BOOTCAMP_* environment variables and then the client
+// defaults.
+func retryOption(data DevOpsAPIProviderModel, diags *diag.Diagnostics) ClientOption {
+	retries, minWait, maxWait := defaultMaxRetries, defaultRetryMinWait, defaultRetryMaxWait
+
+	maxRetries := os.Getenv("BOOTCAMP_MAX_RETRIES")
+	if !data.MaxRetries.IsNull() && !data.MaxRetries.IsUnknown() {
+		maxRetries = strconv.FormatInt(data.MaxRetries.ValueInt64(), 10)
+	}
+	if maxRetries != "" {
+		parsed, err := strconv.Atoi(maxRetries)
+		if err != nil || parsed < 0 {
+			diags.AddAttributeError(
+				path.Root("max_retries"),
+				"Invalid Max Retries",
+				fmt.Sprintf("The max_retries value %q must be a non-negative integer.", maxRetries),
+			)
+		} else {
+			retries = parsed
+		}
+	}
+
+	if wait, ok := durationAttribute(data.RetryMinWait, "BOOTCAMP_RETRY_MIN_WAIT", path.Root("retry_min_wait"), diags); ok {
+		minWait = wait
+	}
+	if wait, ok := durationAttribute(data.RetryMaxWait, "BOOTCAMP_RETRY_MAX_WAIT", path.Root("retry_max_wait"), diags); ok {
+		maxWait = wait
+	}
+
+	if minWait > maxWait {
+		diags.AddAttributeError(
+			path.Root("retry_min_wait"),
+			"Invalid Retry Wait",
+			fmt.Sprintf("The retry_min_wait value (%s) must not be greater than retry_max_wait (%s).", minWait, maxWait),
+		)
+	}
+
+	return WithRetry(retries, minWait, maxWait)
+}
+
+// pageSizeAttribute returns the page_size setting, falling back to the
+// BOOTCAMP_PAGE_SIZE environment variable. ok is false when neither is set or
+// the value is invalid.
+func pageSizeAttribute(value types.Int64, diags *diag.Diagnostics) (int, bool) {
+	pageSize := os.Getenv("BOOTCAMP_PAGE_SIZE")
+	if !value.IsNull() && !value.IsUnknown() {
+		pageSize = strconv.FormatInt(value.ValueInt64(), 10)
+	}
+	if pageSize == "" {
+		return 0, false
+	}
+
+	parsed, err := strconv.Atoi(pageSize)
+	if err != nil || parsed < 0 {
+		diags.AddAttributeError(
+			path.Root("page_size"),
+			"Invalid Page Size",
+			fmt.Sprintf("The page_size value %q must be a non-negative integer.", pageSize),
+		)
+		return 0, false
+	}
+
+	return parsed, true
+}
+
+// strictDecodingAttribute returns the strict_decoding setting, falling back to
+// the BOOTCAMP_STRICT_DECODING environment variable.
+func strictDecodingAttribute(value types.Bool, diags *diag.Diagnostics) bool {
+	if !value.IsNull() && !value.IsUnknown() {
+		return value.ValueBool()
+	}
+
+	env := os.Getenv("BOOTCAMP_STRICT_DECODING")
+	if env == "" {
+		return false
+	}
+
+	strict, err := strconv.ParseBool(env)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("strict_decoding"),
+			"Invalid Strict Decoding",
+			fmt.Sprintf("The BOOTCAMP_STRICT_DECODING value %q must be a boolean.", env),
+		)
+		return false
+	}
+
+	return strict
+}
+
+// authOptions builds the credentials and extra headers from the provider
+// configuration, falling back to the BOOTCAMP_API_* environment variables.
+func authOptions(ctx context.Context, data DevOpsAPIProviderModel, diags *diag.Diagnostics) []ClientOption {
+	token := stringAttribute(data.Token, "BOOTCAMP_API_TOKEN")
+	username := stringAttribute(data.Username, "BOOTCAMP_API_USERNAME")
+	password := stringAttribute(data.Password, "BOOTCAMP_API_PASSWORD")
+
+	// The config validators only see the configuration, so repeat the checks
+	// once the environment variables have been taken into account.
+	if token != "" && (username != "" || password != "") {
+		diags.AddAttributeError(
+			path.Root("token"),
+			"Conflicting DevOps API Credentials",
+			"A token cannot be combined with username and password. "+
+				"Check the provider configuration and the BOOTCAMP_API_TOKEN, BOOTCAMP_API_USERNAME and BOOTCAMP_API_PASSWORD environment variables.",
+		)
+	}
+	if (username == "") != (password == "") {
+		diags.AddAttributeError(
+			path.Root("username"),
+			"Incomplete DevOps API Credentials",
+			"Basic authentication requires both a username and a password.",
+		)
+	}
+
+	var opts []ClientOption
+	switch {
+	case token != "":
+		opts = append(opts, WithToken(token))
+	case username != "":
+		opts = append(opts, WithBasicAuth(username, password))
+	}
+
+	if data.Headers.IsNull() || data.Headers.IsUnknown() {
+		return opts
+	}
+
+	configured := map[string]string{}
+	diags.Append(data.Headers.ElementsAs(ctx, &configured, false)...)
+	if diags.HasError() {
+		return opts
+	}
+
+	headers := make(map[string]string, len(configured))
+	for name, value := range configured {
+		if http.CanonicalHeaderKey(name) == "Authorization" && (token != "" || username != "") {
+			diags.AddAttributeError(
+				path.Root("headers").AtMapKey(name),
+				"Conflicting DevOps API Credentials",
+				"An Authorization header cannot be combined with token or basic authentication.",
+			)
+			continue
+		}
+		headers[name] = value
+	}
+
+	return append(opts, WithHeaders(headers))
+}
+
+// clientTLSConfig builds the TLS configuration from the CA bundle, client
+// certificate and verification settings of the provider configuration. It
+// returns nil when none of them are set.
+func clientTLSConfig(data DevOpsAPIProviderModel, diags *diag.Diagnostics) *tls.Config {
+	unknown := map[string]bool{
+		"ca_cert_pem":          data.CACertPEM.IsUnknown(),
+		"ca_cert_file":         data.CACertFile.IsUnknown(),
+		"client_cert":          data.ClientCert.IsUnknown(),
+		"client_key":           data.ClientKey.IsUnknown(),
+		"insecure_skip_verify": data.InsecureSkipVerify.IsUnknown(),
+	}
+	for attr, isUnknown := range unknown {
+		if isUnknown {
+			diags.AddAttributeError(
+				path.Root(attr),
+				"Unknown DevOps API TLS Setting",
+				fmt.Sprintf("The provider cannot create the DevOps API client as there is an unknown configuration value for %s. "+
+					"Set the value statically in the configuration or use the environment variable instead.", attr),
+			)
+		}
+	}
+	if diags.HasError() {
+		return nil
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	customized := false
+
+	caCertPEM := stringAttribute(data.CACertPEM, "BOOTCAMP_CA_CERT_PEM")
+	caCertPath := path.Root("ca_cert_pem")
+	if caCertFile := stringAttribute(data.CACertFile, "BOOTCAMP_CA_CERT_FILE"); caCertFile != "" {
+		caCertPath = path.Root("ca_cert_file")
+		contents, err := os.ReadFile(caCertFile)
+		if err != nil {
+			diags.AddAttributeError(
+				caCertPath,
+				"Unable to Read CA Certificate File",
+				fmt.Sprintf("The CA certificate file %q could not be read: %s", caCertFile, err),
+			)
+			return nil
+		}
+		caCertPEM = string(contents)
+	}
+
+	if caCertPEM != "" {
+		pool, err := newCertPool([]byte(caCertPEM))
+		if err != nil {
+			diags.AddAttributeError(
+				caCertPath,
+				"Invalid CA Certificate",
+				"The CA certificate bundle could not be parsed: "+err.Error(),
+			)
+			return nil
+		}
+		tlsConfig.RootCAs = pool
+		customized = true
+	}
+
+	clientCert := stringAttribute(data.ClientCert, "BOOTCAMP_CLIENT_CERT")
+	clientKey := stringAttribute(data.ClientKey, "BOOTCAMP_CLIENT_KEY")
+	if (clientCert == "") != (clientKey == "") {
+		diags.AddAttributeError(
+			path.Root("client_cert"),
+			"Incomplete Client Certificate",
+			"Mutual TLS requires both client_cert and client_key.",
+		)
+		return nil
+	}
+	if clientCert != "" {
+		certificate, err := tls.X509KeyPair([]byte(clientCert), []byte(clientKey))
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("client_cert"),
+				"Invalid Client Certificate",
+				"The client certificate and key could not be loaded. Both must be PEM-encoded and belong together: "+err.Error(),
+			)
+			return nil
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+		customized = true
+	}
+
+	insecure := data.InsecureSkipVerify.ValueBool()
+	if data.InsecureSkipVerify.IsNull() {
+		if value := os.Getenv("BOOTCAMP_INSECURE_SKIP_VERIFY"); value != "" {
+			parsed, err := strconv.ParseBool(value)
+			if err != nil {
+				diags.AddAttributeError(
+					path.Root("insecure_skip_verify"),
+					"Invalid Insecure Skip Verify",
+					fmt.Sprintf("The BOOTCAMP_INSECURE_SKIP_VERIFY value %q must be a boolean.", value),
+				)
+				return nil
+			}
+			insecure = parsed
+		}
+	}
+	if insecure {
+		tlsConfig.InsecureSkipVerify = true
+		customized = true
+	}
+
+	if !customized {
+		return nil
+	}
+
+	return tlsConfig
+}
+
+// stringAttribute returns the configured value of a provider attribute,
+// falling back to the given environment variable.
+func stringAttribute(value types.String, env string) string {
+	if !value.IsNull() && !value.IsUnknown() {
+		return value.ValueString()
+	}
+
+	return os.Getenv(env)
+}
+
+// durationAttribute parses a Go duration from a provider attribute or its
+// environment variable fallback. It returns false when neither is set or the
+// value is invalid, in which case an error diagnostic is added.
+func durationAttribute(value types.String, env string, attrPath path.Path, diags *diag.Diagnostics) (time.Duration, bool) {
+	raw := stringAttribute(value, env)
+	if raw == "" {
+		return 0, false
+	}
+
+	duration, err := time.ParseDuration(raw)
+	if err != nil || duration < 0 {
+		diags.AddAttributeError(
+			attrPath,
+			"Invalid Duration",
+			fmt.Sprintf("The value %q is not a valid non-negative duration. Use a Go duration such as \"500ms\" or \"10s\".", raw),
+		)
+		return 0, false
+	}
+
+	return duration, true
+}
+
 func (p *DevOpsAPIProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewEngineerResource,
+		NewLegacyEngineerResource,
 		NewDevResource,
+		NewLegacyDevResource,
+		NewOpsResource,
+		NewDevOpsResource,
+		NewDevEngineerMembershipResource,
 	}
 }
 
@@ -116,6 +566,19 @@ func (p *DevOpsAPIProvider) DataSources(_ context.Context) []func() datasource.D
 	return []func() datasource.DataSource{
 		NewEngineerDataSource,
 		NewDevDataSource,
+		NewOpsDataSource,
+		NewDevOpsDataSource,
+		NewEngineerLookupDataSource,
+	}
+}
+
+// Functions defines the provider-defined functions implemented in the provider.
+func (p *DevOpsAPIProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewNormalizeEmailFunction,
+		NewEmailDomainFunction,
+		NewEngineerIdsFunction,
+		NewTeamDiffFunction,
 	}
 }
 
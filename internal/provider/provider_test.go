@@ -7,13 +7,11 @@ import (
 
 const (
 	// providerConfig is a shared configuration to combine with the actual
-	// test configuration so the HashiCups client is properly configured.
-	// It is also possible to use the HASHICUPS_ environment variables instead,
-	// such as updating the Makefile and running the testing through that tool.
+	// test configuration. The endpoint is left out on purpose: testAccFakeAPI
+	// starts an in-process fake of the bootcamp API for every test and points
+	// the provider at it through the BOOTCAMP_API_ENDPOINT environment variable.
 	providerConfig = `
-provider "devops-bootcamp" {
-  endpoint = "http://localhost:8080"
-}
+provider "devops-bootcamp" {}
 `
 )
 
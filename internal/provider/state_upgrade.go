@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// stateMigration upgrades the state of a resource by one schema version.
+type stateMigration struct {
+	// priorSchema is the schema of the version being upgraded from. States
+	// of that version are checked against it before migrating.
+	priorSchema schema.Schema
+
+	// migrate rewrites the JSON state object of the prior version into the
+	// next version in place.
+	migrate func(ctx context.Context, state map[string]any) error
+}
+
+// stateUpgraders chains migrations into the upgraders of a resource whose
+// current schema is version len(migrations). Migration i upgrades version i,
+// so a state of any prior version runs through every later migration.
+//
+// Migrations work on the raw JSON state, so each one only needs to know the
+// two versions it converts between.
+func stateUpgraders(current schema.Schema, migrations []stateMigration) map[int64]resource.StateUpgrader {
+	if current.Version != int64(len(migrations)) {
+		panic(fmt.Sprintf("schema version %d does not match the %d state migrations", current.Version, len(migrations)))
+	}
+
+	upgraders := make(map[int64]resource.StateUpgrader, len(migrations))
+	for version := range migrations {
+		upgraders[int64(version)] = resource.StateUpgrader{
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				value, err := upgradeRawState(ctx, req.RawState, current, migrations[version:])
+				if err == nil {
+					var dynamicValue tfprotov6.DynamicValue
+					dynamicValue, err = tfprotov6.NewDynamicValue(value.Type(), value)
+					resp.DynamicValue = &dynamicValue
+				}
+				if err != nil {
+					resp.Diagnostics.AddError(
+						"Unable to upgrade resource state",
+						fmt.Sprintf("An error occurred while upgrading the resource state from version %d to %d: %s", version, current.Version, err),
+					)
+				}
+			},
+		}
+	}
+
+	return upgraders
+}
+
+// upgradeRawState checks rawState against the schema it was stored with and
+// runs the remaining migrations on it, returning a state of the current schema.
+func upgradeRawState(ctx context.Context, rawState *tfprotov6.RawState, current schema.Schema, migrations []stateMigration) (tftypes.Value, error) {
+	if rawState == nil {
+		return tftypes.Value{}, fmt.Errorf("missing raw state")
+	}
+
+	// Reject states that don't match the schema they claim to be stored with
+	priorSchema := current
+	if len(migrations) > 0 {
+		priorSchema = migrations[0].priorSchema
+	}
+	if _, err := rawState.Unmarshal(priorSchema.Type().TerraformType(ctx)); err != nil {
+		return tftypes.Value{}, fmt.Errorf("state does not match schema version %d: %w", priorSchema.Version, err)
+	}
+
+	var state map[string]any
+	if err := json.Unmarshal(rawState.JSON, &state); err != nil {
+		return tftypes.Value{}, err
+	}
+
+	for _, migration := range migrations {
+		if err := migration.migrate(ctx, state); err != nil {
+			return tftypes.Value{}, err
+		}
+	}
+
+	upgraded, err := json.Marshal(state)
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+
+	value, err := (&tfprotov6.RawState{JSON: upgraded}).Unmarshal(current.Type().TerraformType(ctx))
+	if err != nil {
+		return tftypes.Value{}, fmt.Errorf("upgraded state does not match the current schema: %w", err)
+	}
+
+	return value, nil
+}
+
+// stateMover moves the state of a resource of sourceTypeName from this
+// provider into a resource with the current schema, for example when a
+// resource type is renamed. States of older schema versions run through the
+// remaining migrations, like in stateUpgraders.
+func stateMover(sourceTypeName string, current schema.Schema, migrations []stateMigration) resource.StateMover {
+	return resource.StateMover{
+		StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+			// Leave moves from other resource types to the other movers
+			if req.SourceTypeName != sourceTypeName || !strings.HasSuffix(req.SourceProviderAddress, "/devops-bootcamp") {
+				return
+			}
+
+			if req.SourceSchemaVersion < 0 || req.SourceSchemaVersion > current.Version {
+				resp.Diagnostics.AddError(
+					"Unable to move resource state",
+					fmt.Sprintf("The %s state has schema version %d, which this provider version does not know. "+
+						"Upgrade the provider to the version that wrote the state.", sourceTypeName, req.SourceSchemaVersion),
+				)
+				return
+			}
+
+			value, err := upgradeRawState(ctx, req.SourceRawState, current, migrations[req.SourceSchemaVersion:])
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Unable to move resource state",
+					fmt.Sprintf("An error occurred while moving the %s state: %s", sourceTypeName, err),
+				)
+				return
+			}
+
+			resp.TargetState.Raw = value
+		},
+	}
+}
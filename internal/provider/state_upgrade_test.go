@@ -0,0 +1,295 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+func TestUpgradeResourceState(t *testing.T) {
+	testCases := map[string]struct {
+		resource  func() fwresource.Resource
+		typeName  string
+		version   int64
+		rawState  string
+		wantState string
+		wantError string
+	}{
+		"engineer-v0": {
+			resource:  NewEngineerResource,
+			typeName:  "devops-bootcamp_engineer-resource",
+			version:   0,
+			rawState:  `{"id": "00001", "name": "Ryan", "email": "ryan@ferrets.com"}`,
+			wantState: `{"id": "00001", "name": "Ryan", "email": "ryan@ferrets.com"}`,
+		},
+		"engineer-v0-empty-email": {
+			resource:  NewEngineerResource,
+			typeName:  "devops-bootcamp_engineer",
+			version:   0,
+			rawState:  `{"id": "00003", "name": "bob", "email": ""}`,
+			wantState: `{"id": "00003", "name": "bob", "email": null}`,
+		},
+		"engineer-v1": {
+			resource:  NewEngineerResource,
+			typeName:  "devops-bootcamp_engineer",
+			version:   1,
+			rawState:  `{"id": "00003", "name": "bob", "email": ""}`,
+			wantState: `{"id": "00003", "name": "bob", "email": "", "created_at": null, "updated_at": null, "version": null}`,
+		},
+		"engineer-v2": {
+			resource: NewEngineerResource,
+			typeName: "devops-bootcamp_engineer",
+			version:  2,
+			rawState: `{
+				"id": "00003",
+				"name": "bob",
+				"email": "bob@bob.com",
+				"created_at": "2024-01-01T00:00:00Z",
+				"updated_at": "2024-01-02T00:00:00Z",
+				"version": 2
+			}`,
+			wantState: `{
+				"id": "00003",
+				"name": "bob",
+				"email": "bob@bob.com",
+				"created_at": "2024-01-01T00:00:00Z",
+				"updated_at": "2024-01-02T00:00:00Z",
+				"version": 2
+			}`,
+		},
+		"dev-v0-engineer-objects": {
+			resource: NewDevResource,
+			typeName: "devops-bootcamp_dev_resource",
+			version:  0,
+			rawState: `{
+				"id": "00004",
+				"name": "dev_ferrets",
+				"engineers": [
+					{"id": "00001", "name": "Ryan", "email": "ryan@ferrets.com"},
+					{"id": "00001", "name": "Ryan", "email": "ryan@ferrets.com"},
+					{"id": null, "name": "ghost", "email": null}
+				]
+			}`,
+			wantState: `{
+				"id": "00004",
+				"name": "dev_ferrets",
+				"engineer_ids": ["00001"],
+				"engineers": [
+					{"id": null, "name": "ghost", "email": null},
+					{"id": "00001", "name": "Ryan", "email": "ryan@ferrets.com"}
+				]
+			}`,
+		},
+		"dev-v0-no-engineers": {
+			resource:  NewDevResource,
+			typeName:  "devops-bootcamp_dev_resource",
+			version:   0,
+			rawState:  `{"id": "00004", "name": "dev_ferrets", "engineers": null}`,
+			wantState: `{"id": "00004", "name": "dev_ferrets", "engineer_ids": [], "engineers": []}`,
+		},
+		"dev-v2": {
+			resource:  NewDevResource,
+			typeName:  "devops-bootcamp_dev_team",
+			version:   2,
+			rawState:  `{"id": "00005", "name": "dev_bengal", "engineer_ids": [], "engineers": []}`,
+			wantState: `{"id": "00005", "name": "dev_bengal", "engineer_ids": [], "engineers": [], "created_at": null, "updated_at": null, "version": null}`,
+		},
+		"dev-v0-schema-mismatch": {
+			resource:  NewDevResource,
+			typeName:  "devops-bootcamp_dev_resource",
+			version:   0,
+			rawState:  `{"id": "00004", "name": "dev_ferrets", "engineer_ids": []}`,
+			wantError: "state does not match schema version 0",
+		},
+		"dev-v1-engineer-list": {
+			resource: NewDevResource,
+			typeName: "devops-bootcamp_dev_resource",
+			version:  1,
+			rawState: `{
+				"id": "00004",
+				"name": "dev_ferrets",
+				"engineer_ids": ["00001"],
+				"engineers": [
+					{"id": "00001", "name": "Ryan", "email": "ryan@ferrets.com"},
+					{"id": "00001", "name": "Ryan", "email": "ryan@ferrets.com"}
+				]
+			}`,
+			wantState: `{
+				"id": "00004",
+				"name": "dev_ferrets",
+				"engineer_ids": ["00001"],
+				"engineers": [{"id": "00001", "name": "Ryan", "email": "ryan@ferrets.com"}]
+			}`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			server, err := testAccProtoV6ProviderFactories["devops-bootcamp"]()
+			if err != nil {
+				t.Fatalf("unexpected error creating provider server: %s", err)
+			}
+
+			resp, err := server.UpgradeResourceState(ctx, &tfprotov6.UpgradeResourceStateRequest{
+				TypeName: testCase.typeName,
+				Version:  testCase.version,
+				RawState: &tfprotov6.RawState{JSON: []byte(testCase.rawState)},
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if testCase.wantError != "" {
+				if len(resp.Diagnostics) == 0 || !strings.Contains(resp.Diagnostics[0].Detail, testCase.wantError) {
+					t.Fatalf("expected diagnostic containing %q, got: %v", testCase.wantError, resp.Diagnostics)
+				}
+				return
+			}
+			for _, d := range resp.Diagnostics {
+				t.Fatalf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+			}
+
+			var schemaResp fwresource.SchemaResponse
+			testCase.resource().Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+			stateType := schemaResp.Schema.Type().TerraformType(ctx)
+
+			got, err := resp.UpgradedState.Unmarshal(stateType)
+			if err != nil {
+				t.Fatalf("unexpected error decoding upgraded state: %s", err)
+			}
+			want, err := (&tfprotov6.RawState{JSON: []byte(testCase.wantState)}).Unmarshal(stateType)
+			if err != nil {
+				t.Fatalf("unexpected error decoding expected state: %s", err)
+			}
+
+			// Sets compare by value, so the element order doesn't matter
+			if !got.Equal(want) {
+				t.Errorf("expected state %s, got: %s", want, got)
+			}
+		})
+	}
+}
+
+func TestMoveResourceState(t *testing.T) {
+	testCases := map[string]struct {
+		sourceTypeName string
+		sourceVersion  int64
+		sourceState    string
+		targetTypeName string
+		targetResource func() fwresource.Resource
+		wantState      string
+		wantError      string
+	}{
+		"engineer": {
+			sourceTypeName: "devops-bootcamp_engineer-resource",
+			sourceVersion:  0,
+			sourceState:    `{"id": "00001", "name": "Ryan", "email": "ryan@ferrets.com"}`,
+			targetTypeName: "devops-bootcamp_engineer",
+			targetResource: NewEngineerResource,
+			wantState:      `{"id": "00001", "name": "Ryan", "email": "ryan@ferrets.com"}`,
+		},
+		"engineer-v0-empty-email": {
+			sourceTypeName: "devops-bootcamp_engineer-resource",
+			sourceVersion:  0,
+			sourceState:    `{"id": "00003", "name": "bob", "email": ""}`,
+			targetTypeName: "devops-bootcamp_engineer",
+			targetResource: NewEngineerResource,
+			wantState:      `{"id": "00003", "name": "bob", "email": null}`,
+		},
+		"dev-v0": {
+			sourceTypeName: "devops-bootcamp_dev_resource",
+			sourceVersion:  0,
+			sourceState: `{
+				"id": "00004",
+				"name": "dev_ferrets",
+				"engineers": [{"id": "00001", "name": "Ryan", "email": "ryan@ferrets.com"}]
+			}`,
+			targetTypeName: "devops-bootcamp_dev_team",
+			targetResource: NewDevResource,
+			wantState: `{
+				"id": "00004",
+				"name": "dev_ferrets",
+				"engineer_ids": ["00001"],
+				"engineers": [{"id": "00001", "name": "Ryan", "email": "ryan@ferrets.com"}]
+			}`,
+		},
+		"dev-v2": {
+			sourceTypeName: "devops-bootcamp_dev_resource",
+			sourceVersion:  2,
+			sourceState:    `{"id": "00004", "name": "dev_ferrets", "engineer_ids": [], "engineers": []}`,
+			targetTypeName: "devops-bootcamp_dev_team",
+			targetResource: NewDevResource,
+			wantState:      `{"id": "00004", "name": "dev_ferrets", "engineer_ids": [], "engineers": []}`,
+		},
+		"dev-future-version": {
+			sourceTypeName: "devops-bootcamp_dev_resource",
+			sourceVersion:  4,
+			sourceState:    `{"id": "00004", "name": "dev_ferrets"}`,
+			targetTypeName: "devops-bootcamp_dev_team",
+			targetResource: NewDevResource,
+			wantError:      "schema version 4",
+		},
+		"unrelated-type": {
+			sourceTypeName: "devops-bootcamp_ops_resource",
+			sourceVersion:  0,
+			sourceState:    `{"id": "00006", "name": "ops_ferrets", "engineers": []}`,
+			targetTypeName: "devops-bootcamp_dev_team",
+			targetResource: NewDevResource,
+			wantError:      "devops-bootcamp_ops_resource",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			server, err := testAccProtoV6ProviderFactories["devops-bootcamp"]()
+			if err != nil {
+				t.Fatalf("unexpected error creating provider server: %s", err)
+			}
+
+			resp, err := server.MoveResourceState(ctx, &tfprotov6.MoveResourceStateRequest{
+				SourceProviderAddress: "registry.terraform.io/liatrio/devops-bootcamp",
+				SourceTypeName:        testCase.sourceTypeName,
+				SourceSchemaVersion:   testCase.sourceVersion,
+				SourceState:           &tfprotov6.RawState{JSON: []byte(testCase.sourceState)},
+				TargetTypeName:        testCase.targetTypeName,
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if testCase.wantError != "" {
+				if len(resp.Diagnostics) == 0 || !strings.Contains(resp.Diagnostics[0].Detail, testCase.wantError) {
+					t.Fatalf("expected diagnostic containing %q, got: %v", testCase.wantError, resp.Diagnostics)
+				}
+				return
+			}
+			for _, d := range resp.Diagnostics {
+				t.Fatalf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+			}
+
+			var schemaResp fwresource.SchemaResponse
+			testCase.targetResource().Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+			stateType := schemaResp.Schema.Type().TerraformType(ctx)
+
+			got, err := resp.TargetState.Unmarshal(stateType)
+			if err != nil {
+				t.Fatalf("unexpected error decoding moved state: %s", err)
+			}
+			want, err := (&tfprotov6.RawState{JSON: []byte(testCase.wantState)}).Unmarshal(stateType)
+			if err != nil {
+				t.Fatalf("unexpected error decoding expected state: %s", err)
+			}
+
+			if !got.Equal(want) {
+				t.Errorf("expected state %s, got: %s", want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &TeamDiffFunction{}
+
+var teamDiffReturnAttrTypes = map[string]attr.Type{
+	"added":   types.ListType{ElemType: types.StringType},
+	"removed": types.ListType{ElemType: types.StringType},
+}
+
+type teamDiffModel struct {
+	Added   []string `tfsdk:"added"`
+	Removed []string `tfsdk:"removed"`
+}
+
+func NewTeamDiffFunction() function.Function {
+	return &TeamDiffFunction{}
+}
+
+type TeamDiffFunction struct{}
+
+func (f *TeamDiffFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "team_diff"
+}
+
+func (f *TeamDiffFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Compare two engineer rosters",
+		MarkdownDescription: "Compares two lists of engineer objects by `id` and returns an object with the sorted ids of the engineers " +
+			"that are in `b` but not in `a` (`added`) and the ones that are in `a` but not in `b` (`removed`).",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "a",
+				MarkdownDescription: "The original roster: a list of engineer objects with an `id` attribute.",
+				ElementType:         engineerObjectType,
+			},
+			function.ListParameter{
+				Name:                "b",
+				MarkdownDescription: "The new roster: a list of engineer objects with an `id` attribute.",
+				ElementType:         engineerObjectType,
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: teamDiffReturnAttrTypes,
+		},
+	}
+}
+
+func (f *TeamDiffFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var a, b []engineerIdModel
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &a, &b))
+
+	if resp.Error != nil {
+		return
+	}
+
+	idsA, knownA := engineerIds(a)
+	idsB, knownB := engineerIds(b)
+	if !knownA || !knownB {
+		resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, types.ObjectUnknown(teamDiffReturnAttrTypes)))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, teamDiffModel{
+		Added:   missingIds(idsB, idsA),
+		Removed: missingIds(idsA, idsB),
+	}))
+}
+
+// missingIds returns the sorted, deduplicated ids of from that are not in other.
+func missingIds(from, other []string) []string {
+	exclude := make(map[string]bool, len(other))
+	for _, id := range other {
+		exclude[id] = true
+	}
+
+	missing := []string{}
+	for _, id := range from {
+		if !exclude[id] {
+			missing = append(missing, id)
+			exclude[id] = true
+		}
+	}
+	sort.Strings(missing)
+
+	return missing
+}
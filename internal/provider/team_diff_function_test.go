@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestTeamDiffFunction(t *testing.T) {
+	testCases := map[string]struct {
+		a, b     types.List
+		expected function.RunResponse
+	}{
+		"added-and-removed": {
+			a: testEngineerList(types.StringValue("00001"), types.StringValue("00002")),
+			b: testEngineerList(types.StringValue("00003"), types.StringValue("00002"), types.StringValue("00004")),
+			expected: function.RunResponse{
+				Result: function.NewResultData(testTeamDiff([]string{"00003", "00004"}, []string{"00001"})),
+			},
+		},
+		"unchanged-order-insensitive": {
+			a: testEngineerList(types.StringValue("00001"), types.StringValue("00002")),
+			b: testEngineerList(types.StringValue("00002"), types.StringValue("00001")),
+			expected: function.RunResponse{
+				Result: function.NewResultData(testTeamDiff([]string{}, []string{})),
+			},
+		},
+		"duplicates": {
+			a: testEngineerList(),
+			b: testEngineerList(types.StringValue("00002"), types.StringValue("00002")),
+			expected: function.RunResponse{
+				Result: function.NewResultData(testTeamDiff([]string{"00002"}, []string{})),
+			},
+		},
+		"unknown-id": {
+			a: testEngineerList(types.StringValue("00001")),
+			b: testEngineerList(types.StringUnknown()),
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.ObjectUnknown(teamDiffReturnAttrTypes)),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := function.RunResponse{
+				Result: function.NewResultData(types.ObjectUnknown(teamDiffReturnAttrTypes)),
+			}
+
+			NewTeamDiffFunction().Run(context.Background(), function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{testCase.a, testCase.b}),
+			}, &got)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func testTeamDiff(added, removed []string) types.Object {
+	toList := func(ids []string) types.List {
+		values := make([]attr.Value, 0, len(ids))
+		for _, id := range ids {
+			values = append(values, types.StringValue(id))
+		}
+		return types.ListValueMust(types.StringType, values)
+	}
+
+	return types.ObjectValueMust(teamDiffReturnAttrTypes, map[string]attr.Value{
+		"added":   toList(added),
+		"removed": toList(removed),
+	})
+}
+
+func TestTeamDiffFunction_config(t *testing.T) {
+	testAccFakeAPI(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "devops-bootcamp_engineer" "test" {
+  name  = "New Hire"
+  email = "new.hire@example.com"
+}
+
+data "devops-bootcamp_dev" "ferrets" {
+  filter {
+    name_regex = "^dev_ferrets$"
+  }
+}
+
+output "test" {
+  value = provider::devops-bootcamp::team_diff(
+    data.devops-bootcamp_dev.ferrets.dev[0].engineers,
+    [devops-bootcamp_engineer.test],
+  )
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"added": knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.StringExact("00009"),
+						}),
+						"removed": knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.StringExact("00001"),
+						}),
+					})),
+				},
+			},
+		},
+	})
+}
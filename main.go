@@ -46,12 +46,12 @@ func main() {
 
 func main() {
 	endpoint := "http://localhost:8080"
-	client, err := provider.NewClient(&endpoint)
+	client, err := provider.NewClient(endpoint)
 	if err != nil {
 		log.Fatalf("Error creating client: %v", err)
 	}
 
-	engineers, err := client.GetEngineers()
+	engineers, err := client.GetEngineers(context.Background())
 	if err != nil {
 		log.Fatalf("Error fetching engineers: %v", err)
 	}